@@ -2,8 +2,6 @@ package arr
 
 import (
 	"fmt"
-	"math/rand"
-	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
@@ -106,11 +104,13 @@ func Divide(array map[string]interface{}) ([]string, []interface{}) {
 }
 
 // Dot flattens a multi-dimensional associative array with dots.
+// Dots and backslashes inside keys are escaped (see EscapeKey) so that Undot
+// can restore the original structure.
 func Dot(array map[string]interface{}, prepend string) map[string]interface{} {
 	results := make(map[string]interface{}, len(array))
 
 	for key, value := range array {
-		prefixedKey := prepend + key
+		prefixedKey := prepend + EscapeKey(key)
 
 		if valueMap, ok := value.(map[string]interface{}); ok && len(valueMap) > 0 {
 			nested := Dot(valueMap, prefixedKey+".")
@@ -126,16 +126,37 @@ func Dot(array map[string]interface{}, prepend string) map[string]interface{} {
 }
 
 // Undot converts a flatten "dot" notation array into an expanded array.
+// Segments are taken literally, so numeric and "*" segments always create map
+// keys and an empty key stays an empty key, as Dot writes it.
 func Undot(array map[string]interface{}) map[string]interface{} {
 	results := make(map[string]interface{})
 
 	for key, value := range array {
-		Set(results, key, value)
+		segments := splitKey(key)
+		current := results
+		for _, segment := range segments[:len(segments)-1] {
+			next, ok := current[segment].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[segment] = next
+			}
+			current = next
+		}
+		current[segments[len(segments)-1]] = value
 	}
 
 	return results
 }
 
+// EscapeKey escapes dots and backslashes in a single key so it can be used as
+// one segment of a "dot" notation path, e.g. "example.com" becomes "example\.com".
+func EscapeKey(key string) string {
+	if !strings.ContainsAny(key, `.\`) {
+		return key
+	}
+	return keyEscaper.Replace(key)
+}
+
 // Except gets all of the given array except for a specified array of keys.
 func Except(array map[string]interface{}, keys []string) map[string]interface{} {
 	result := make(map[string]interface{}, len(array))
@@ -299,33 +320,31 @@ func flattenRecursive(item interface{}, depth int, result *[]interface{}) {
 }
 
 // Forget removes one or many array items from a given array using "dot" notation.
+// Numeric segments remove items from slices and "*" applies to every item, so
+// "users.*" empties the slice and "users.*.password" removes the key from
+// every user. Slices are shrunk in a copy, leaving the backing array of the
+// caller's slice unchanged.
 func Forget(array map[string]interface{}, keys []string) {
 	if array == nil || len(keys) == 0 {
 		return
 	}
 
 	for _, key := range keys {
-		if dotIdx := strings.IndexByte(key, '.'); dotIdx != -1 {
-			parts := strings.Split(key, ".")
-			current := array
-
-			for i := 0; i < len(parts)-1; i++ {
-				if val, ok := current[parts[i]].(map[string]interface{}); ok {
-					current = val
-				} else {
-					goto nextKey
-				}
-			}
+		if key == "" {
+			continue
+		}
 
-			delete(current, parts[len(parts)-1])
-		} else {
+		if _, exists := array[key]; exists {
 			delete(array, key)
+			continue
 		}
-	nextKey:
+		forgetPath(array, splitKey(key))
 	}
 }
 
 // Get gets an item from an array using "dot" notation.
+// Numeric segments index into slices and a "*" segment fans out over every
+// item, returning a slice of all matches.
 func Get(array map[string]interface{}, key string, defaultValue interface{}) interface{} {
 	if array == nil {
 		return defaultValue
@@ -335,32 +354,13 @@ func Get(array map[string]interface{}, key string, defaultValue interface{}) int
 		return array
 	}
 
-	if strings.IndexByte(key, '.') == -1 {
-		if val, exists := array[key]; exists {
-			return val
-		}
-		return defaultValue
+	if val, exists := array[key]; exists {
+		return val
 	}
 
-	parts := strings.Split(key, ".")
-	current := array
-
-	for i, part := range parts {
-		if val, ok := current[part].(map[string]interface{}); ok {
-			if i == len(parts)-1 {
-				return val
-			}
-			current = val
-		} else {
-			if i == len(parts)-1 {
-				if val, exists := current[part]; exists {
-					return val
-				}
-			}
-			return defaultValue
-		}
+	if val, found := lookup(array, splitKey(key)); found {
+		return val
 	}
-
 	return defaultValue
 }
 
@@ -380,35 +380,18 @@ func Has(array map[string]interface{}, keys []string) bool {
 }
 
 // HasOne checks if a single key exists in an array using "dot" notation.
+// A key containing "*" exists when at least one item matches.
 func HasOne(array map[string]interface{}, key string) bool {
 	if array == nil || key == "" {
 		return false
 	}
 
-	if strings.IndexByte(key, '.') == -1 {
-		_, exists := array[key]
-		return exists
-	}
-
-	parts := strings.Split(key, ".")
-	current := array
-
-	for i, part := range parts {
-		if val, ok := current[part].(map[string]interface{}); ok {
-			if i == len(parts)-1 {
-				return true
-			}
-			current = val
-		} else {
-			if i == len(parts)-1 {
-				_, exists := current[part]
-				return exists
-			}
-			return false
-		}
+	if _, exists := array[key]; exists {
+		return true
 	}
 
-	return false
+	_, found := lookup(array, splitKey(key))
+	return found
 }
 
 // HasAny determines if any of the keys exist in an array using "dot" notation.
@@ -610,16 +593,20 @@ func Pull(array map[string]interface{}, key string, defaultValue interface{}) in
 }
 
 // Query converts the array into a query string.
+// Nested maps and slices are written as filter[status]=active&ids[]=1&ids[]=2;
+// use QueryWith to choose a different slice format.
 func Query(array map[string]interface{}) string {
-	values := url.Values{}
-	for k, v := range array {
-		values.Set(k, toString(v))
-	}
-	return values.Encode()
+	return QueryWith(array, QueryBrackets)
 }
 
 // Random gets one or a specified number of random values from an array.
 func Random(array []interface{}, number int, preserveKeys bool) (interface{}, error) {
+	return RandomWith(RandomSource(), array, number, preserveKeys)
+}
+
+// RandomWith gets one or a specified number of random values from an array
+// using the given randomizer.
+func RandomWith(rng Randomizer, array []interface{}, number int, preserveKeys bool) (interface{}, error) {
 	if len(array) == 0 {
 		if number > 0 {
 			return []interface{}{}, nil
@@ -635,7 +622,7 @@ func Random(array []interface{}, number int, preserveKeys bool) (interface{}, er
 		return nil, fmt.Errorf("you requested %d items, but there are only %d items available", number, len(array))
 	}
 
-	indices := rand.Perm(len(array))[:number]
+	indices := randomIndices(rng, len(array), number)
 
 	if number == 1 {
 		return array[indices[0]], nil
@@ -658,41 +645,40 @@ func Random(array []interface{}, number int, preserveKeys bool) (interface{}, er
 }
 
 // Set sets an array item to a given value using "dot" notation.
+// An existing key that contains dots, such as "user.name", is set as is, as
+// in Get. Numeric segments index into slices, and an index equal to the
+// length of a slice appends to it. Larger or negative indexes are ignored
+// rather than padding the slice with nils, and any other segment replaces the
+// slice with a map, as it does a string or number. A "*" segment writes to
+// every matching item.
 func Set(array map[string]interface{}, key string, value interface{}) map[string]interface{} {
 	if key == "" {
 		return array
 	}
 
-	if strings.IndexByte(key, '.') == -1 {
+	if _, exists := array[key]; exists {
 		array[key] = value
 		return array
 	}
 
-	parts := strings.Split(key, ".")
-	current := array
-
-	for i := 0; i < len(parts)-1; i++ {
-		part := parts[i]
-		if val, ok := current[part].(map[string]interface{}); ok {
-			current = val
-		} else {
-			current[part] = make(map[string]interface{})
-			current = current[part].(map[string]interface{})
-		}
-	}
-
-	current[parts[len(parts)-1]] = value
+	setInMap(array, splitKey(key), value)
 	return array
 }
 
 // Shuffle shuffles the given array and returns the result.
 func Shuffle(array []interface{}) []interface{} {
+	return ShuffleWith(RandomSource(), array)
+}
+
+// ShuffleWith shuffles the given array using the given randomizer and returns the result.
+func ShuffleWith(rng Randomizer, array []interface{}) []interface{} {
 	result := make([]interface{}, len(array))
 	copy(result, array)
 
-	rand.Shuffle(len(result), func(i, j int) {
+	for i := len(result) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
 		result[i], result[j] = result[j], result[i]
-	})
+	}
 
 	return result
 }
@@ -858,6 +844,261 @@ func Wrap(value interface{}) []interface{} {
 	return []interface{}{value}
 }
 
+var keyEscaper = strings.NewReplacer(`\`, `\\`, ".", `\.`)
+
+// splitKey splits a "dot" notation key into its segments. A backslash escapes
+// a following dot or backslash; any other backslash is kept as is.
+func splitKey(key string) []string {
+	if strings.IndexByte(key, '\\') == -1 {
+		return strings.Split(key, ".")
+	}
+
+	segments := make([]string, 0, strings.Count(key, ".")+1)
+	var current strings.Builder
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case c == '\\' && i+1 < len(key) && (key[i+1] == '.' || key[i+1] == '\\'):
+			current.WriteByte(key[i+1])
+			i++
+		case c == '.':
+			segments = append(segments, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	return append(segments, current.String())
+}
+
+// lookup walks the segments through nested maps and slices.
+func lookup(target interface{}, segments []string) (interface{}, bool) {
+	for i, segment := range segments {
+		if segment == "*" {
+			return lookupWildcard(target, segments[i+1:])
+		}
+
+		switch node := target.(type) {
+		case map[string]interface{}:
+			val, exists := node[segment]
+			if !exists {
+				return nil, false
+			}
+			target = val
+		case []interface{}:
+			idx, ok := sliceIndex(segment, len(node))
+			if !ok {
+				return nil, false
+			}
+			target = node[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return target, true
+}
+
+// lookupWildcard collects the matches of the remaining segments for every
+// item of target. Results of nested wildcards are collapsed into one slice.
+func lookupWildcard(target interface{}, rest []string) (interface{}, bool) {
+	items, ok := wildcardItems(target)
+	if !ok {
+		return nil, false
+	}
+
+	nested := false
+	for _, segment := range rest {
+		if segment == "*" {
+			nested = true
+			break
+		}
+	}
+
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		val, found := lookup(item, rest)
+		if !found {
+			continue
+		}
+		if matches, isSlice := val.([]interface{}); nested && isSlice {
+			result = append(result, matches...)
+		} else {
+			result = append(result, val)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, false
+	}
+	return result, true
+}
+
+// wildcardItems returns the children of a map (ordered by key) or slice.
+func wildcardItems(target interface{}) ([]interface{}, bool) {
+	switch node := target.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(node))
+		for k := range node {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		items := make([]interface{}, len(keys))
+		for i, k := range keys {
+			items[i] = node[k]
+		}
+		return items, true
+	case []interface{}:
+		return node, true
+	default:
+		return nil, false
+	}
+}
+
+// sliceIndex parses a segment as an index into a slice of the given length.
+func sliceIndex(segment string, length int) (int, bool) {
+	idx, err := strconv.Atoi(segment)
+	if err != nil || idx < 0 || idx >= length {
+		return 0, false
+	}
+	return idx, true
+}
+
+// setInMap sets the value at the given segments inside a map.
+func setInMap(array map[string]interface{}, segments []string, value interface{}) {
+	segment, rest := segments[0], segments[1:]
+
+	if segment == "*" {
+		for k := range array {
+			if len(rest) == 0 {
+				array[k] = value
+			} else {
+				array[k] = setInto(array[k], rest, value)
+			}
+		}
+		return
+	}
+
+	if len(rest) == 0 {
+		array[segment] = value
+		return
+	}
+
+	array[segment] = setInto(array[segment], rest, value)
+}
+
+// setInSlice sets the value at the given segments inside a slice, returning
+// the slice with at most one item appended, or a new map when the segment is
+// not an index.
+func setInSlice(array []interface{}, segments []string, value interface{}) interface{} {
+	segment, rest := segments[0], segments[1:]
+
+	if segment == "*" {
+		for i := range array {
+			if len(rest) == 0 {
+				array[i] = value
+			} else {
+				array[i] = setInto(array[i], rest, value)
+			}
+		}
+		return array
+	}
+
+	idx, err := strconv.Atoi(segment)
+	if err != nil {
+		result := make(map[string]interface{})
+		setInMap(result, segments, value)
+		return result
+	}
+	if idx < 0 || idx > len(array) {
+		return array
+	}
+
+	if idx == len(array) {
+		array = append(array, nil)
+	}
+
+	if len(rest) == 0 {
+		array[idx] = value
+	} else {
+		array[idx] = setInto(array[idx], rest, value)
+	}
+	return array
+}
+
+// setInto sets the value inside target, replacing anything that is not a map
+// or slice with a new map.
+func setInto(target interface{}, segments []string, value interface{}) interface{} {
+	switch node := target.(type) {
+	case map[string]interface{}:
+		setInMap(node, segments, value)
+		return node
+	case []interface{}:
+		return setInSlice(node, segments, value)
+	default:
+		result := make(map[string]interface{})
+		setInMap(result, segments, value)
+		return result
+	}
+}
+
+// forgetPath removes the item at the given segments, returning the possibly
+// shrunk target.
+func forgetPath(target interface{}, segments []string) interface{} {
+	segment, rest := segments[0], segments[1:]
+
+	switch node := target.(type) {
+	case map[string]interface{}:
+		if segment == "*" {
+			if len(rest) == 0 {
+				clear(node)
+				return node
+			}
+			for k, v := range node {
+				node[k] = forgetPath(v, rest)
+			}
+			return node
+		}
+
+		val, exists := node[segment]
+		if !exists {
+			return node
+		}
+		if len(rest) == 0 {
+			delete(node, segment)
+		} else {
+			node[segment] = forgetPath(val, rest)
+		}
+		return node
+	case []interface{}:
+		if segment == "*" && len(rest) == 0 {
+			return []interface{}{}
+		}
+		idx, ok := sliceIndex(segment, len(node))
+		if segment != "*" && !ok {
+			return node
+		}
+
+		// Work on a copy so the caller's backing array is left unchanged
+		result := make([]interface{}, len(node))
+		copy(result, node)
+		if segment == "*" {
+			for i, v := range result {
+				result[i] = forgetPath(v, rest)
+			}
+			return result
+		}
+		if len(rest) == 0 {
+			return append(result[:idx], result[idx+1:]...)
+		}
+		result[idx] = forgetPath(result[idx], rest)
+		return result
+	default:
+		return target
+	}
+}
+
 // Helper function to convert interface{} to string
 func toString(v interface{}) string {
 	if v == nil {
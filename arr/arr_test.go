@@ -220,6 +220,20 @@ func TestDot(t *testing.T) {
 			"",
 			map[string]interface{}{},
 		},
+		{
+			"keys containing dots are escaped",
+			map[string]interface{}{
+				"hosts": map[string]interface{}{
+					"example.com": map[string]interface{}{"ttl": 300},
+				},
+				`C:\temp`: 1,
+			},
+			"",
+			map[string]interface{}{
+				`hosts.example\.com.ttl`: 300,
+				`C:\\temp`:               1,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -256,6 +270,35 @@ func TestUndot(t *testing.T) {
 			map[string]interface{}{"a": 1, "b": 2},
 			map[string]interface{}{"a": 1, "b": 2},
 		},
+		{
+			"escaped dots",
+			map[string]interface{}{
+				`hosts.example\.com.ttl`: 300,
+				`versions.v1\.2`:         "stable",
+			},
+			map[string]interface{}{
+				"hosts": map[string]interface{}{
+					"example.com": map[string]interface{}{"ttl": 300},
+				},
+				"versions": map[string]interface{}{"v1.2": "stable"},
+			},
+		},
+		{
+			"numeric and wildcard segments are literal",
+			map[string]interface{}{"list.0": "a", "any.*": "b"},
+			map[string]interface{}{
+				"list": map[string]interface{}{"0": "a"},
+				"any":  map[string]interface{}{"*": "b"},
+			},
+		},
+		{
+			"empty key",
+			map[string]interface{}{"": "root", "a.": "nested"},
+			map[string]interface{}{
+				"":  "root",
+				"a": map[string]interface{}{"": "nested"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -268,6 +311,49 @@ func TestUndot(t *testing.T) {
 	}
 }
 
+func TestDotUndotRoundTrip(t *testing.T) {
+	original := map[string]interface{}{
+		"hosts": map[string]interface{}{
+			"example.com": map[string]interface{}{"ttl": 300},
+			"a\\.b":       map[string]interface{}{"c.d": true},
+		},
+		"versions": map[string]interface{}{"v1.2": "stable"},
+		"empty":    map[string]interface{}{},
+		`path\`:    "trailing backslash",
+		"":         "top-level empty key",
+		"blank":    map[string]interface{}{"": "nested empty key"},
+	}
+
+	result := Undot(Dot(original, ""))
+	if !reflect.DeepEqual(result, original) {
+		t.Errorf("Undot(Dot(x)) = %v, want %v", result, original)
+	}
+}
+
+func TestEscapeKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"plain", "plain"},
+		{"example.com", `example\.com`},
+		{`C:\temp`, `C:\\temp`},
+		{`a\.b`, `a\\\.b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			result := EscapeKey(tt.key)
+			if result != tt.expected {
+				t.Errorf("EscapeKey(%q) = %q, want %q", tt.key, result, tt.expected)
+			}
+			if segments := splitKey(result); len(segments) != 1 || segments[0] != tt.key {
+				t.Errorf("splitKey(EscapeKey(%q)) = %q", tt.key, segments)
+			}
+		})
+	}
+}
+
 func TestExcept(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -517,6 +603,54 @@ func TestForget(t *testing.T) {
 			[]string{"a", "c"},
 			map[string]interface{}{"b": 2},
 		},
+		{
+			"slice index",
+			map[string]interface{}{
+				"users": []interface{}{"John", "Jane", "Bob"},
+			},
+			[]string{"users.1"},
+			map[string]interface{}{
+				"users": []interface{}{"John", "Bob"},
+			},
+		},
+		{
+			"wildcard",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "John", "password": "secret"},
+					map[string]interface{}{"name": "Jane", "password": "hidden"},
+				},
+			},
+			[]string{"users.*.password"},
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "John"},
+					map[string]interface{}{"name": "Jane"},
+				},
+			},
+		},
+		{
+			"index out of range",
+			map[string]interface{}{"users": []interface{}{"John"}},
+			[]string{"users.5"},
+			map[string]interface{}{"users": []interface{}{"John"}},
+		},
+		{
+			"escaped dot",
+			map[string]interface{}{
+				"hosts": map[string]interface{}{"example.com": 1, "example": 2},
+			},
+			[]string{`hosts.example\.com`},
+			map[string]interface{}{
+				"hosts": map[string]interface{}{"example": 2},
+			},
+		},
+		{
+			"exact key containing dot",
+			map[string]interface{}{"v1.2": 1, "v1": map[string]interface{}{"2": 2}},
+			[]string{"v1.2"},
+			map[string]interface{}{"v1": map[string]interface{}{"2": 2}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -529,6 +663,27 @@ func TestForget(t *testing.T) {
 	}
 }
 
+func TestForgetLeavesCallerSliceUnchanged(t *testing.T) {
+	users := []interface{}{"John", "Jane", "Bob"}
+	Forget(map[string]interface{}{"users": users}, []string{"users.0"})
+	if want := []interface{}{"John", "Jane", "Bob"}; !reflect.DeepEqual(users, want) {
+		t.Errorf("Forget() changed the caller's slice to %v, want %v", users, want)
+	}
+
+	nested := []interface{}{[]interface{}{1, 2}, []interface{}{3, 4}}
+	Forget(map[string]interface{}{"rows": nested}, []string{"rows.*.0"})
+	if want := []interface{}{[]interface{}{1, 2}, []interface{}{3, 4}}; !reflect.DeepEqual(nested, want) {
+		t.Errorf("Forget() changed the caller's slice to %v, want %v", nested, want)
+	}
+
+	all := []interface{}{"a", "b"}
+	data := map[string]interface{}{"all": all}
+	Forget(data, []string{"all.*"})
+	if len(data["all"].([]interface{})) != 0 || all[0] != "a" {
+		t.Errorf("Forget() = %v with caller's slice %v, want empty and unchanged", data["all"], all)
+	}
+}
+
 func TestGet(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -576,6 +731,94 @@ func TestGet(t *testing.T) {
 			"default",
 			"default",
 		},
+		{
+			"slice index",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"email": "john@example.com"},
+					map[string]interface{}{"email": "jane@example.com"},
+				},
+			},
+			"users.1.email",
+			nil,
+			"jane@example.com",
+		},
+		{
+			"slice index out of range",
+			map[string]interface{}{"users": []interface{}{"John"}},
+			"users.3",
+			"default",
+			"default",
+		},
+		{
+			"wildcard over slice",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"email": "john@example.com"},
+					map[string]interface{}{"name": "No email"},
+					map[string]interface{}{"email": "jane@example.com"},
+				},
+			},
+			"users.*.email",
+			nil,
+			[]interface{}{"john@example.com", "jane@example.com"},
+		},
+		{
+			"wildcard over map ordered by key",
+			map[string]interface{}{
+				"servers": map[string]interface{}{
+					"b": map[string]interface{}{"port": 81},
+					"a": map[string]interface{}{"port": 80},
+				},
+			},
+			"servers.*.port",
+			nil,
+			[]interface{}{80, 81},
+		},
+		{
+			"nested wildcards collapse",
+			map[string]interface{}{
+				"teams": []interface{}{
+					map[string]interface{}{"members": []interface{}{"a", "b"}},
+					map[string]interface{}{"members": []interface{}{"c"}},
+				},
+			},
+			"teams.*.members.*",
+			nil,
+			[]interface{}{"a", "b", "c"},
+		},
+		{
+			"wildcard without matches",
+			map[string]interface{}{"users": []interface{}{}},
+			"users.*.email",
+			"default",
+			"default",
+		},
+		{
+			"escaped dot",
+			map[string]interface{}{
+				"hosts": map[string]interface{}{
+					"example.com": map[string]interface{}{"ttl": 300},
+				},
+			},
+			`hosts.example\.com.ttl`,
+			nil,
+			300,
+		},
+		{
+			"exact key containing dot",
+			map[string]interface{}{"v1.2": "stable"},
+			"v1.2",
+			nil,
+			"stable",
+		},
+		{
+			"lone backslash is literal",
+			map[string]interface{}{`C:\temp`: 1},
+			`C:\temp`,
+			nil,
+			1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -656,6 +899,42 @@ func TestHasOne(t *testing.T) {
 		},
 		{"nil map", nil, "a", false},
 		{"empty key", map[string]interface{}{"a": 1}, "", false},
+		{
+			"slice index exists",
+			map[string]interface{}{"users": []interface{}{"John"}},
+			"users.0",
+			true,
+		},
+		{
+			"slice index missing",
+			map[string]interface{}{"users": []interface{}{"John"}},
+			"users.1",
+			false,
+		},
+		{
+			"wildcard match",
+			map[string]interface{}{
+				"users": []interface{}{map[string]interface{}{"email": "john@example.com"}},
+			},
+			"users.*.email",
+			true,
+		},
+		{
+			"wildcard no match",
+			map[string]interface{}{
+				"users": []interface{}{map[string]interface{}{"name": "John"}},
+			},
+			"users.*.email",
+			false,
+		},
+		{
+			"escaped dot",
+			map[string]interface{}{
+				"hosts": map[string]interface{}{"example.com": 1},
+			},
+			`hosts.example\.com`,
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1236,6 +1515,102 @@ func TestSet(t *testing.T) {
 			2,
 			map[string]interface{}{"a": 1},
 		},
+		{
+			"slice index",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"email": "john@example.com"},
+				},
+			},
+			"users.0.email",
+			"new@example.com",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"email": "new@example.com"},
+				},
+			},
+		},
+		{
+			"slice index appends",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+			"tags.1",
+			"b",
+			map[string]interface{}{"tags": []interface{}{"a", "b"}},
+		},
+		{
+			"slice index past the end is ignored",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+			"tags.3",
+			"c",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+		},
+		{
+			"huge slice index is ignored",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+			"tags.999999999",
+			"c",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+		},
+		{
+			"negative slice index is ignored",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+			"tags.-1",
+			"c",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+		},
+		{
+			"key on a slice replaces it with a map",
+			map[string]interface{}{"tags": []interface{}{"a"}},
+			"tags.first",
+			"b",
+			map[string]interface{}{"tags": map[string]interface{}{"first": "b"}},
+		},
+		{
+			"existing dotted key",
+			map[string]interface{}{"user.name": "John", "user": map[string]interface{}{"name": "Jane"}},
+			"user.name",
+			"Joe",
+			map[string]interface{}{"user.name": "Joe", "user": map[string]interface{}{"name": "Jane"}},
+		},
+		{
+			"wildcard writes every match",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "John"},
+					map[string]interface{}{"name": "Jane"},
+				},
+			},
+			"users.*.active",
+			true,
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "John", "active": true},
+					map[string]interface{}{"name": "Jane", "active": true},
+				},
+			},
+		},
+		{
+			"wildcard over map",
+			map[string]interface{}{
+				"limits": map[string]interface{}{"a": 1, "b": 2},
+			},
+			"limits.*",
+			0,
+			map[string]interface{}{
+				"limits": map[string]interface{}{"a": 0, "b": 0},
+			},
+		},
+		{
+			"escaped dot",
+			map[string]interface{}{},
+			`hosts.example\.com.ttl`,
+			300,
+			map[string]interface{}{
+				"hosts": map[string]interface{}{
+					"example.com": map[string]interface{}{"ttl": 300},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
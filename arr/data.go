@@ -0,0 +1,467 @@
+package arr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DataGet gets an item from a struct, map, slice or pointer using "dot" notation.
+// Struct fields are matched by their json tag first and then by field name.
+// Numeric segments index into slices and arrays, and a "*" segment fans out
+// over every item, returning a slice of all matches.
+func DataGet(target interface{}, key string, defaultValue interface{}) interface{} {
+	if target == nil {
+		return defaultValue
+	}
+
+	if key == "" {
+		return target
+	}
+
+	val, found := dataLookup(reflect.ValueOf(target), splitKey(key))
+	if !found || !val.IsValid() || !val.CanInterface() {
+		return defaultValue
+	}
+	return val.Interface()
+}
+
+// DataSet sets an item on a struct, map, slice or pointer using "dot" notation.
+// The target must be a pointer or a non-nil map so the change is visible to the
+// caller; a slice target can only be updated in place. Missing map entries, nil
+// pointers and nil maps along the path are created, and values are converted
+// to the destination type when possible. An index equal to the length of a
+// slice appends to it, and larger indexes are an error.
+func DataSet(target interface{}, key string, value interface{}) error {
+	if target == nil {
+		return fmt.Errorf("cannot set %q on a nil target", key)
+	}
+
+	if key == "" {
+		return fmt.Errorf("cannot set an empty key")
+	}
+
+	rv := reflect.ValueOf(target)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return fmt.Errorf("cannot set %q on a nil pointer", key)
+		}
+	case reflect.Map:
+		if rv.IsNil() {
+			return fmt.Errorf("cannot set %q on a nil map", key)
+		}
+	case reflect.Slice:
+	default:
+		return fmt.Errorf("cannot set %q on a non-pointer %s", key, rv.Type())
+	}
+
+	_, err := dataAssign(rv, splitKey(key), value)
+	return err
+}
+
+// dataLookup walks the segments through structs, maps, slices and pointers.
+func dataLookup(target reflect.Value, segments []string) (reflect.Value, bool) {
+	for i, segment := range segments {
+		target = indirect(target)
+		if !target.IsValid() {
+			return reflect.Value{}, false
+		}
+
+		if segment == "*" {
+			return dataLookupWildcard(target, segments[i+1:])
+		}
+
+		child, ok := dataChild(target, segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		target = child
+	}
+
+	return target, true
+}
+
+// dataLookupWildcard collects the matches of the remaining segments for every
+// item of target. Results of nested wildcards are collapsed into one slice.
+func dataLookupWildcard(target reflect.Value, rest []string) (reflect.Value, bool) {
+	var items []reflect.Value
+	switch target.Kind() {
+	case reflect.Map:
+		keys := target.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			items = append(items, target.MapIndex(k))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < target.Len(); i++ {
+			items = append(items, target.Index(i))
+		}
+	default:
+		return reflect.Value{}, false
+	}
+
+	nested := false
+	for _, segment := range rest {
+		if segment == "*" {
+			nested = true
+			break
+		}
+	}
+
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		val, found := dataLookup(item, rest)
+		if !found || !val.IsValid() || !val.CanInterface() {
+			continue
+		}
+		if matches, isSlice := val.Interface().([]interface{}); nested && isSlice {
+			result = append(result, matches...)
+		} else {
+			result = append(result, val.Interface())
+		}
+	}
+
+	if len(result) == 0 {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(result), true
+}
+
+// dataChild returns the direct child of target named by segment.
+func dataChild(target reflect.Value, segment string) (reflect.Value, bool) {
+	switch target.Kind() {
+	case reflect.Map:
+		key, ok := mapKey(segment, target.Type().Key())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		val := target.MapIndex(key)
+		return val, val.IsValid()
+	case reflect.Struct:
+		return structField(target, segment)
+	case reflect.Slice, reflect.Array:
+		idx, ok := sliceIndex(segment, target.Len())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return target.Index(idx), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// dataAssign sets value at the given segments inside target and returns the
+// value that should be stored in place of target. Non-addressable structs and
+// arrays are copied, and slices may grow by one item.
+func dataAssign(target reflect.Value, segments []string, value interface{}) (reflect.Value, error) {
+	segment, rest := segments[0], segments[1:]
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.IsNil() {
+			if !target.CanSet() {
+				return target, fmt.Errorf("cannot set %q on a nil pointer", segment)
+			}
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		elem, err := dataAssign(target.Elem(), segments, value)
+		if err != nil {
+			return target, err
+		}
+		target.Elem().Set(elem)
+		return target, nil
+
+	case reflect.Interface:
+		elem := target.Elem()
+		if !elem.IsValid() {
+			elem = reflect.ValueOf(make(map[string]interface{}))
+		}
+		// Work on an addressable copy so structs held in interfaces can be updated
+		holder := reflect.New(elem.Type()).Elem()
+		holder.Set(elem)
+		updated, err := dataAssign(holder, segments, value)
+		if err != nil {
+			return target, err
+		}
+		return updated, nil
+
+	case reflect.Map:
+		if target.IsNil() {
+			target = reflect.MakeMap(target.Type())
+		}
+		if segment == "*" {
+			for _, k := range target.MapKeys() {
+				if err := dataAssignMapEntry(target, k, rest, value); err != nil {
+					return target, err
+				}
+			}
+			return target, nil
+		}
+		k, ok := mapKey(segment, target.Type().Key())
+		if !ok {
+			return target, fmt.Errorf("cannot use %q as key of %s", segment, target.Type())
+		}
+		return target, dataAssignMapEntry(target, k, rest, value)
+
+	case reflect.Struct:
+		if !target.CanAddr() {
+			holder := reflect.New(target.Type()).Elem()
+			holder.Set(target)
+			target = holder
+		}
+		field, ok := structField(target, segment)
+		if !ok || !field.CanSet() {
+			return target, fmt.Errorf("field %q not found on %s", segment, target.Type())
+		}
+		return target, dataAssignElem(field, rest, value)
+
+	case reflect.Slice, reflect.Array:
+		if target.Kind() == reflect.Array && !target.CanAddr() {
+			holder := reflect.New(target.Type()).Elem()
+			holder.Set(target)
+			target = holder
+		}
+		if segment == "*" {
+			for i := 0; i < target.Len(); i++ {
+				if err := dataAssignElem(target.Index(i), rest, value); err != nil {
+					return target, err
+				}
+			}
+			return target, nil
+		}
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 {
+			return target, fmt.Errorf("cannot use %q as index of %s", segment, target.Type())
+		}
+		if idx >= target.Len() {
+			if target.Kind() == reflect.Array || idx > target.Len() {
+				return target, fmt.Errorf("index %d out of range for %s", idx, target.Type())
+			}
+			// A grown slice could not be stored back, so the write would be lost
+			if !target.CanSet() {
+				return target, fmt.Errorf("index %d out of range, slice not addressable", idx)
+			}
+			grown := reflect.MakeSlice(target.Type(), idx+1, idx+1)
+			reflect.Copy(grown, target)
+			target = grown
+		}
+		return target, dataAssignElem(target.Index(idx), rest, value)
+
+	default:
+		return target, fmt.Errorf("cannot set %q on %s", segment, target.Type())
+	}
+}
+
+// dataAssignElem sets value inside an addressable element.
+func dataAssignElem(elem reflect.Value, rest []string, value interface{}) error {
+	if len(rest) == 0 {
+		converted, err := convertValue(value, elem.Type())
+		if err != nil {
+			return err
+		}
+		elem.Set(converted)
+		return nil
+	}
+
+	updated, err := dataAssign(elem, rest, value)
+	if err != nil {
+		return err
+	}
+	elem.Set(updated)
+	return nil
+}
+
+// dataAssignMapEntry sets value inside the map entry stored under key.
+func dataAssignMapEntry(target, key reflect.Value, rest []string, value interface{}) error {
+	elem := reflect.New(target.Type().Elem()).Elem()
+	if current := target.MapIndex(key); current.IsValid() {
+		elem.Set(current)
+	}
+
+	if err := dataAssignElem(elem, rest, value); err != nil {
+		return err
+	}
+	target.SetMapIndex(key, elem)
+	return nil
+}
+
+// indirect dereferences pointers and interfaces until a concrete value is found.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// structField finds an exported field by json tag, field name, or
+// case-insensitive field name, in that order.
+func structField(target reflect.Value, name string) (reflect.Value, bool) {
+	fields := reflect.VisibleFields(target.Type())
+
+	match := func(matches func(reflect.StructField) bool) (reflect.Value, bool) {
+		for _, f := range fields {
+			if !f.IsExported() || !matches(f) {
+				continue
+			}
+			field, err := target.FieldByIndexErr(f.Index)
+			if err != nil {
+				return reflect.Value{}, false
+			}
+			return field, true
+		}
+		return reflect.Value{}, false
+	}
+
+	if field, ok := match(func(f reflect.StructField) bool {
+		return jsonName(f) == name
+	}); ok {
+		return field, true
+	}
+
+	if field, ok := match(func(f reflect.StructField) bool {
+		return jsonName(f) != "-" && f.Name == name
+	}); ok {
+		return field, true
+	}
+
+	return match(func(f reflect.StructField) bool {
+		return jsonName(f) != "-" && strings.EqualFold(f.Name, name)
+	})
+}
+
+// jsonName returns the name from a field's json tag, if any.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
+
+// mapKey converts a path segment into a key of the given type.
+func mapKey(segment string, keyType reflect.Type) (reflect.Value, bool) {
+	switch keyType.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(segment).Convert(keyType), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(segment, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(keyType), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(segment, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(keyType), true
+	case reflect.Interface:
+		return reflect.ValueOf(segment), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// convertValue converts value to the given type, allowing conversions between
+// numeric kinds and between string kinds only. Numbers that overflow the type
+// or lose their fraction are rejected.
+func convertValue(value interface{}, t reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("cannot assign nil to %s", t)
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+
+	if isNumberKind(v.Kind()) && isNumberKind(t.Kind()) {
+		if !convertsExactly(v, t) {
+			return reflect.Value{}, fmt.Errorf("cannot assign %v to %s without overflow or loss of precision", value, t)
+		}
+		return v.Convert(t), nil
+	}
+	if v.Kind() == reflect.String && t.Kind() == reflect.String {
+		return v.Convert(t), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot assign %s to %s", v.Type(), t)
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertsExactly reports whether the number v keeps its value when converted
+// to the numeric type t.
+func convertsExactly(v reflect.Value, t reflect.Type) bool {
+	target := reflect.New(t).Elem()
+
+	switch {
+	case isIntKind(t.Kind()):
+		switch {
+		case isIntKind(v.Kind()):
+			return !target.OverflowInt(v.Int())
+		case isUintKind(v.Kind()):
+			return v.Uint() <= math.MaxInt64 && !target.OverflowInt(int64(v.Uint()))
+		default:
+			f := v.Float()
+			return f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !target.OverflowInt(int64(f))
+		}
+
+	case isUintKind(t.Kind()):
+		switch {
+		case isIntKind(v.Kind()):
+			return v.Int() >= 0 && !target.OverflowUint(uint64(v.Int()))
+		case isUintKind(v.Kind()):
+			return !target.OverflowUint(v.Uint())
+		default:
+			f := v.Float()
+			return f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !target.OverflowUint(uint64(f))
+		}
+
+	default:
+		switch {
+		case isIntKind(v.Kind()):
+			f := v.Convert(t).Float()
+			return f >= math.MinInt64 && f < math.MaxInt64 && int64(f) == v.Int()
+		case isUintKind(v.Kind()):
+			f := v.Convert(t).Float()
+			return f < math.MaxUint64 && uint64(f) == v.Uint()
+		default:
+			return !target.OverflowFloat(v.Float())
+		}
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,264 @@
+package arr
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type dataAddress struct {
+	City    string `json:"city"`
+	ZipCode string `json:"zip_code,omitempty"`
+}
+
+type dataMeta struct {
+	Tags []string `json:"tags"`
+}
+
+type dataUser struct {
+	dataMeta
+	Name     string                 `json:"name"`
+	Email    string                 `json:"email"`
+	Password string                 `json:"-"`
+	Age      int                    `json:"age"`
+	Address  *dataAddress           `json:"address"`
+	Labels   map[string]string      `json:"labels"`
+	Extra    map[string]interface{} `json:"extra"`
+	Friends  []dataUser             `json:"friends"`
+	Nickname string
+	secret   string
+}
+
+func newDataUser() *dataUser {
+	return &dataUser{
+		dataMeta: dataMeta{Tags: []string{"admin", "staff"}},
+		Name:     "John",
+		Email:    "john@example.com",
+		Password: "secret",
+		Age:      30,
+		Address:  &dataAddress{City: "Jakarta", ZipCode: "10110"},
+		Labels:   map[string]string{"team": "core"},
+		Extra: map[string]interface{}{
+			"settings": map[string]interface{}{"theme": "dark"},
+		},
+		Friends: []dataUser{
+			{Name: "Jane", Email: "jane@example.com"},
+			{Name: "Bob", Email: "bob@example.com"},
+		},
+		Nickname: "johnny",
+		secret:   "hidden",
+	}
+}
+
+func TestDataGet(t *testing.T) {
+	user := newDataUser()
+
+	tests := []struct {
+		name         string
+		target       interface{}
+		key          string
+		defaultValue interface{}
+		expected     interface{}
+	}{
+		{"json tag", user, "name", nil, "John"},
+		{"json tag with options", user, "address.zip_code", nil, "10110"},
+		{"through pointer", user, "address.city", nil, "Jakarta"},
+		{"struct value", *user, "age", nil, 30},
+		{"field name without tag", user, "Nickname", nil, "johnny"},
+		{"case-insensitive field name", user, "nickname", nil, "johnny"},
+		{"ignored json field", user, "Password", "default", "default"},
+		{"unexported field", user, "secret", "default", "default"},
+		{"embedded struct field", user, "tags.1", nil, "staff"},
+		{"typed map", user, "labels.team", nil, "core"},
+		{"nested interface map", user, "extra.settings.theme", nil, "dark"},
+		{"slice of structs", user, "friends.1.name", nil, "Bob"},
+		{"wildcard", user, "friends.*.email", nil, []interface{}{"jane@example.com", "bob@example.com"}},
+		{"missing", user, "address.country", "default", "default"},
+		{"index out of range", user, "friends.5.name", "default", "default"},
+		{"nil pointer", &dataUser{}, "address.city", "default", "default"},
+		{"map with int keys", map[int]string{1: "one"}, "1", nil, "one"},
+		{"nil target", nil, "a", "default", "default"},
+		{"empty key", map[string]string{"a": "b"}, "", nil, map[string]string{"a": "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DataGet(tt.target, tt.key, tt.defaultValue)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DataGet() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDataSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		value       interface{}
+		expectError bool
+		check       func(u *dataUser) bool
+	}{
+		{"json tag", "name", "Jane", false, func(u *dataUser) bool { return u.Name == "Jane" }},
+		{"converts numbers", "age", 31.0, false, func(u *dataUser) bool { return u.Age == 31 }},
+		{"through pointer", "address.city", "Bandung", false, func(u *dataUser) bool { return u.Address.City == "Bandung" }},
+		{"typed map", "labels.role", "lead", false, func(u *dataUser) bool { return u.Labels["role"] == "lead" }},
+		{"nested interface map", "extra.settings.theme", "light", false, func(u *dataUser) bool {
+			return DataGet(u, "extra.settings.theme", nil) == "light"
+		}},
+		{"creates missing interface map", "extra.limits.max", 10, false, func(u *dataUser) bool {
+			return DataGet(u, "extra.limits.max", nil) == 10
+		}},
+		{"slice element", "friends.0.name", "Janet", false, func(u *dataUser) bool { return u.Friends[0].Name == "Janet" }},
+		{"grows slice", "friends.2.name", "Eve", false, func(u *dataUser) bool {
+			return len(u.Friends) == 3 && u.Friends[2].Name == "Eve"
+		}},
+		{"index past the end", "friends.3.name", "Eve", true, nil},
+		{"wildcard", "friends.*.email", "hidden", false, func(u *dataUser) bool {
+			return u.Friends[0].Email == "hidden" && u.Friends[1].Email == "hidden"
+		}},
+		{"embedded struct field", "tags.0", "owner", false, func(u *dataUser) bool { return u.Tags[0] == "owner" }},
+		{"unknown field", "unknown", "x", true, nil},
+		{"incompatible type", "age", "thirty", true, nil},
+		{"path through scalar", "name.first", "x", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newDataUser()
+			err := DataSet(user, tt.key, tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("DataSet() expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DataSet() error = %v", err)
+			}
+			if !tt.check(user) {
+				t.Errorf("DataSet(%q, %v) did not apply: %+v", tt.key, tt.value, user)
+			}
+		})
+	}
+
+	t.Run("allocates nil pointer", func(t *testing.T) {
+		user := &dataUser{}
+		if err := DataSet(user, "address.city", "Surabaya"); err != nil {
+			t.Fatalf("DataSet() error = %v", err)
+		}
+		if user.Address == nil || user.Address.City != "Surabaya" {
+			t.Errorf("DataSet() address = %+v", user.Address)
+		}
+	})
+
+	t.Run("struct inside map", func(t *testing.T) {
+		target := map[string]dataAddress{"home": {City: "Jakarta"}}
+		if err := DataSet(target, "home.city", "Medan"); err != nil {
+			t.Fatalf("DataSet() error = %v", err)
+		}
+		if target["home"].City != "Medan" {
+			t.Errorf("DataSet() = %+v", target)
+		}
+	})
+
+	t.Run("non-pointer struct", func(t *testing.T) {
+		if err := DataSet(dataUser{}, "name", "x"); err == nil {
+			t.Errorf("DataSet() expected error but got nil")
+		}
+	})
+
+	t.Run("slice target updated in place", func(t *testing.T) {
+		target := []int{1, 2}
+		if err := DataSet(target, "1", 9); err != nil {
+			t.Fatalf("DataSet() error = %v", err)
+		}
+		if target[1] != 9 {
+			t.Errorf("DataSet() = %v", target)
+		}
+	})
+
+	t.Run("slice target cannot grow", func(t *testing.T) {
+		target := []int{1, 2}
+		if err := DataSet(target, "5", 9); err == nil {
+			t.Errorf("DataSet() expected error but got nil")
+		}
+		if len(target) != 2 || target[0] != 1 || target[1] != 2 {
+			t.Errorf("DataSet() changed the slice to %v", target)
+		}
+	})
+
+	t.Run("slice pointer grows", func(t *testing.T) {
+		target := []int{1, 2}
+		if err := DataSet(&target, "2", 9); err != nil {
+			t.Fatalf("DataSet() error = %v", err)
+		}
+		if len(target) != 3 || target[2] != 9 {
+			t.Errorf("DataSet() = %v", target)
+		}
+		if err := DataSet(&target, "999999999", 9); err == nil {
+			t.Errorf("DataSet() expected error but got nil")
+		}
+	})
+}
+
+func TestDataSetNumberConversion(t *testing.T) {
+	type numbers struct {
+		Small    uint8
+		Int      int
+		Int8     int8
+		Uint     uint
+		Float32  float32
+		Float64  float64
+		Unsigned uint64
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		value       interface{}
+		expectError bool
+		check       func(n *numbers) bool
+	}{
+		{"int fits uint8", "Small", 200, false, func(n *numbers) bool { return n.Small == 200 }},
+		{"int overflows uint8", "Small", 300, true, nil},
+		{"negative int to uint", "Uint", -1, true, nil},
+		{"int overflows int8", "Int8", 128, true, nil},
+		{"negative int fits int8", "Int8", int64(-128), false, func(n *numbers) bool { return n.Int8 == -128 }},
+		{"integral float to int", "Int", 42.0, false, func(n *numbers) bool { return n.Int == 42 }},
+		{"fractional float to int", "Int", 1.9, true, nil},
+		{"negative fraction to int", "Int", -0.5, true, nil},
+		{"NaN to int", "Int", math.NaN(), true, nil},
+		{"infinity to int", "Int", math.Inf(1), true, nil},
+		{"float beyond int64", "Int", 1e19, true, nil},
+		{"float to uint", "Uint", 7.0, false, func(n *numbers) bool { return n.Uint == 7 }},
+		{"negative float to uint", "Uint", -7.0, true, nil},
+		{"large uint to int", "Int", uint64(math.MaxUint64), true, nil},
+		{"uint fits int", "Int", uint(12), false, func(n *numbers) bool { return n.Int == 12 }},
+		{"int to float", "Float64", 3, false, func(n *numbers) bool { return n.Float64 == 3 }},
+		{"int loses precision in float", "Float64", int64(1<<53 + 1), true, nil},
+		{"uint loses precision in float32", "Float32", uint64(1<<24 + 1), true, nil},
+		{"float64 fits float32", "Float32", 0.5, false, func(n *numbers) bool { return n.Float32 == 0.5 }},
+		{"float64 overflows float32", "Float32", 1e300, true, nil},
+		{"max uint64", "Unsigned", uint64(math.MaxUint64), false, func(n *numbers) bool { return n.Unsigned == math.MaxUint64 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &numbers{}
+			err := DataSet(n, tt.key, tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("DataSet(%q, %v) expected error but got nil, result %+v", tt.key, tt.value, n)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DataSet() error = %v", err)
+			}
+			if !tt.check(n) {
+				t.Errorf("DataSet(%q, %v) = %+v", tt.key, tt.value, n)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Package generic provides type-safe counterparts of the slice and map
+// helpers found in the arr package.
+package generic
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/rulzi/helper-go/arr"
+)
+
+// Where filters the array using the given callback.
+func Where[T any](array []T, callback func(T) bool) []T {
+	result := make([]T, 0, len(array)/2) // Pre-allocate with estimated capacity
+	for _, item := range array {
+		if callback(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Reject filters the array using the negation of the given callback.
+func Reject[T any](array []T, callback func(T) bool) []T {
+	return Where(array, func(item T) bool {
+		return !callback(item)
+	})
+}
+
+// Map runs a map over each of the items in the array.
+func Map[T, U any](array []T, callback func(T) U) []U {
+	result := make([]U, len(array))
+	for i, item := range array {
+		result[i] = callback(item)
+	}
+	return result
+}
+
+// First returns the first element in an array passing a given truth test.
+func First[T any](array []T, callback func(T) bool, defaultValue T) T {
+	if len(array) == 0 {
+		return defaultValue
+	}
+
+	if callback == nil {
+		return array[0]
+	}
+
+	for _, item := range array {
+		if callback(item) {
+			return item
+		}
+	}
+
+	return defaultValue
+}
+
+// Last returns the last element in an array passing a given truth test.
+func Last[T any](array []T, callback func(T) bool, defaultValue T) T {
+	if len(array) == 0 {
+		return defaultValue
+	}
+
+	if callback == nil {
+		return array[len(array)-1]
+	}
+
+	for i := len(array) - 1; i >= 0; i-- {
+		if callback(array[i]) {
+			return array[i]
+		}
+	}
+
+	return defaultValue
+}
+
+// Take takes the first or last {$limit} items from an array and returns them
+// in a new slice.
+func Take[T any](array []T, limit int) []T {
+	if limit < 0 {
+		start := len(array) + limit
+		if start < 0 {
+			start = 0
+		}
+		return slices.Clone(array[start:])
+	}
+
+	if limit > len(array) {
+		limit = len(array)
+	}
+
+	return slices.Clone(array[:limit])
+}
+
+// Sort sorts the array using the given callback, which reports whether its
+// first argument sorts before the second. The callback is required; use
+// SortOrdered for numbers and strings.
+func Sort[T any](array []T, callback func(T, T) bool) []T {
+	if callback == nil {
+		panic("generic.Sort: nil callback")
+	}
+
+	result := slices.Clone(array)
+	if result == nil {
+		result = []T{}
+	}
+
+	slices.SortStableFunc(result, func(a, b T) int {
+		switch {
+		case callback(a, b):
+			return -1
+		case callback(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result
+}
+
+// SortDesc sorts the array in descending order using the given callback,
+// which is required as in Sort.
+func SortDesc[T any](array []T, callback func(T, T) bool) []T {
+	if callback == nil {
+		panic("generic.SortDesc: nil callback")
+	}
+
+	return Sort(array, func(a, b T) bool {
+		return callback(b, a)
+	})
+}
+
+// SortOrdered sorts an array of ordered items, such as numbers or strings, in
+// ascending order.
+func SortOrdered[T cmp.Ordered](array []T) []T {
+	result := slices.Clone(array)
+	if result == nil {
+		result = []T{}
+	}
+
+	slices.Sort(result)
+	return result
+}
+
+// SortOrderedDesc sorts an array of ordered items in descending order.
+func SortOrderedDesc[T cmp.Ordered](array []T) []T {
+	result := slices.Clone(array)
+	if result == nil {
+		result = []T{}
+	}
+
+	slices.SortStableFunc(result, func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
+	return result
+}
+
+// Shuffle shuffles the given array using arr.RandomSource and returns the result.
+func Shuffle[T any](array []T) []T {
+	return ShuffleWith(arr.RandomSource(), array)
+}
+
+// ShuffleWith shuffles the given array using the given randomizer and returns the result.
+func ShuffleWith[T any](rng arr.Randomizer, array []T) []T {
+	result := make([]T, len(array))
+	copy(result, array)
+
+	for i := len(result) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result
+}
+
+// KeyBy keys an array by the value returned from the given callback.
+func KeyBy[T any, K comparable](array []T, keyBy func(T) K) map[K]T {
+	result := make(map[K]T, len(array))
+	for _, item := range array {
+		result[keyBy(item)] = item
+	}
+	return result
+}
+
+// MapWithKeys runs an associative map over each of the items.
+func MapWithKeys[T any, K comparable, V any](array []T, callback func(T) map[K]V) map[K]V {
+	result := make(map[K]V, len(array))
+	for _, item := range array {
+		for k, v := range callback(item) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// WhereMap filters the map using the given callback.
+func WhereMap[K comparable, V any](array map[K]V, callback func(V, K) bool) map[K]V {
+	result := make(map[K]V, len(array))
+	for k, v := range array {
+		if callback(v, k) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// RejectMap filters the map using the negation of the given callback.
+func RejectMap[K comparable, V any](array map[K]V, callback func(V, K) bool) map[K]V {
+	return WhereMap(array, func(v V, k K) bool {
+		return !callback(v, k)
+	})
+}
+
+// MapValues runs a map over each of the values in the map, preserving keys.
+func MapValues[K comparable, V, U any](array map[K]V, callback func(V, K) U) map[K]U {
+	result := make(map[K]U, len(array))
+	for k, v := range array {
+		result[k] = callback(v, k)
+	}
+	return result
+}
+
+// Divide divides a map into two arrays. One with keys and the other with values.
+func Divide[K comparable, V any](array map[K]V) ([]K, []V) {
+	keys := make([]K, 0, len(array))
+	values := make([]V, 0, len(array))
+
+	for k, v := range array {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	return keys, values
+}
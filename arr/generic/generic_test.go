@@ -0,0 +1,329 @@
+package generic
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+type user struct {
+	ID   int
+	Name string
+	Age  int
+}
+
+var users = []user{
+	{1, "John", 30},
+	{2, "Jane", 25},
+	{3, "Bob", 35},
+}
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		callback func(int) bool
+		expected []int
+	}{
+		{"filter even", []int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 }, []int{2, 4}},
+		{"none match", []int{1, 3, 5}, func(n int) bool { return n%2 == 0 }, []int{}},
+		{"empty", []int{}, func(n int) bool { return true }, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Where(tt.array, tt.callback)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Where() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("structs", func(t *testing.T) {
+		result := Where(users, func(u user) bool { return u.Age >= 30 })
+		expected := []user{users[0], users[2]}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Where() = %v, want %v", result, expected)
+		}
+	})
+}
+
+func TestReject(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		callback func(int) bool
+		expected []int
+	}{
+		{"reject even", []int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 }, []int{1, 3, 5}},
+		{"reject all", []int{1, 2}, func(n int) bool { return true }, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Reject(tt.array, tt.callback)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Reject() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	names := Map(users, func(u user) string { return u.Name })
+	expected := []string{"John", "Jane", "Bob"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Map() = %v, want %v", names, expected)
+	}
+
+	empty := Map([]int{}, func(n int) int { return n * 2 })
+	if len(empty) != 0 {
+		t.Errorf("Map() on empty = %v, want empty", empty)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	tests := []struct {
+		name         string
+		array        []int
+		callback     func(int) bool
+		defaultValue int
+		expected     int
+	}{
+		{"no callback", []int{1, 2, 3}, nil, 0, 1},
+		{"with callback", []int{1, 2, 3}, func(n int) bool { return n > 1 }, 0, 2},
+		{"not found", []int{1, 2, 3}, func(n int) bool { return n > 5 }, -1, -1},
+		{"empty", []int{}, nil, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := First(tt.array, tt.callback, tt.defaultValue)
+			if result != tt.expected {
+				t.Errorf("First() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLast(t *testing.T) {
+	tests := []struct {
+		name         string
+		array        []int
+		callback     func(int) bool
+		defaultValue int
+		expected     int
+	}{
+		{"no callback", []int{1, 2, 3}, nil, 0, 3},
+		{"with callback", []int{1, 2, 3}, func(n int) bool { return n < 3 }, 0, 2},
+		{"not found", []int{1, 2, 3}, func(n int) bool { return n > 5 }, -1, -1},
+		{"empty", []int{}, nil, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Last(tt.array, tt.callback, tt.defaultValue)
+			if result != tt.expected {
+				t.Errorf("Last() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		limit    int
+		expected []int
+	}{
+		{"take first", []int{1, 2, 3, 4}, 2, []int{1, 2}},
+		{"take last", []int{1, 2, 3, 4}, -2, []int{3, 4}},
+		{"limit too large", []int{1, 2}, 5, []int{1, 2}},
+		{"negative too large", []int{1, 2}, -5, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.array)
+			result := Take(tt.array, tt.limit)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Take() = %v, want %v", result, tt.expected)
+			}
+			if len(result) > 0 {
+				result[0] = 99
+			}
+			if !reflect.DeepEqual(tt.array, original) {
+				t.Errorf("Take() shares its result with the input: %v", tt.array)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		callback func(int, int) bool
+		expected []int
+	}{
+		{"ascending", []int{3, 1, 2}, func(a, b int) bool { return a < b }, []int{1, 2, 3}},
+		{"empty", []int{}, func(a, b int) bool { return a < b }, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.array)
+			result := Sort(tt.array, tt.callback)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Sort() = %v, want %v", result, tt.expected)
+			}
+			if !reflect.DeepEqual(tt.array, original) {
+				t.Errorf("Sort() modified input: %v", tt.array)
+			}
+		})
+	}
+}
+
+func TestSortDesc(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		callback func(int, int) bool
+		expected []int
+	}{
+		{"descending", []int{1, 3, 2}, func(a, b int) bool { return a < b }, []int{3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SortDesc(tt.array, tt.callback)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortDesc() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSortNilCallback(t *testing.T) {
+	for name, sort := range map[string]func([]int, func(int, int) bool) []int{"Sort": Sort[int], "SortDesc": SortDesc[int]} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s() with a nil callback did not panic", name)
+				}
+			}()
+			sort([]int{2, 1}, nil)
+		})
+	}
+}
+
+func TestSortOrdered(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		expected []int
+		desc     []int
+	}{
+		// 10 sorts before 9 as text but not as a number
+		{"numbers", []int{9, 10, -1, 2}, []int{-1, 2, 9, 10}, []int{10, 9, 2, -1}},
+		{"empty", nil, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.array)
+			if result := SortOrdered(tt.array); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortOrdered() = %v, want %v", result, tt.expected)
+			}
+			if result := SortOrderedDesc(tt.array); !reflect.DeepEqual(result, tt.desc) {
+				t.Errorf("SortOrderedDesc() = %v, want %v", result, tt.desc)
+			}
+			if !reflect.DeepEqual(tt.array, original) {
+				t.Errorf("SortOrdered() modified input: %v", tt.array)
+			}
+		})
+	}
+
+	if result := SortOrdered([]string{"b", "a", "c"}); !reflect.DeepEqual(result, []string{"a", "b", "c"}) {
+		t.Errorf("SortOrdered() = %v, want [a b c]", result)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	array := []int{1, 2, 3, 4, 5}
+	result := Shuffle(array)
+	if len(result) != len(array) {
+		t.Fatalf("Shuffle() length = %d, want %d", len(result), len(array))
+	}
+
+	sorted := append([]int(nil), result...)
+	sort.Ints(sorted)
+	if !reflect.DeepEqual(sorted, array) {
+		t.Errorf("Shuffle() = %v, not a permutation of %v", result, array)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	result := KeyBy(users, func(u user) int { return u.ID })
+	if len(result) != 3 || result[2].Name != "Jane" {
+		t.Errorf("KeyBy() = %v", result)
+	}
+}
+
+func TestMapWithKeys(t *testing.T) {
+	result := MapWithKeys(users, func(u user) map[string]int {
+		return map[string]int{u.Name: u.Age}
+	})
+	expected := map[string]int{"John": 30, "Jane": 25, "Bob": 35}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapWithKeys() = %v, want %v", result, expected)
+	}
+}
+
+func TestWhereMap(t *testing.T) {
+	array := map[string]int{"a": 1, "b": 2, "c": 3}
+	result := WhereMap(array, func(v int, k string) bool { return v > 1 })
+	expected := map[string]int{"b": 2, "c": 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("WhereMap() = %v, want %v", result, expected)
+	}
+}
+
+func TestRejectMap(t *testing.T) {
+	array := map[string]int{"a": 1, "b": 2, "c": 3}
+	result := RejectMap(array, func(v int, k string) bool { return k == "a" })
+	expected := map[string]int{"b": 2, "c": 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("RejectMap() = %v, want %v", result, expected)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	array := map[string]int{"a": 1, "b": 2}
+	result := MapValues(array, func(v int, k string) string { return k + "=" + string(rune('0'+v)) })
+	expected := map[string]string{"a": "a=1", "b": "b=2"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MapValues() = %v, want %v", result, expected)
+	}
+}
+
+func TestDivide(t *testing.T) {
+	keys, values := Divide(map[string]int{"a": 1, "b": 2})
+	sort.Strings(keys)
+	sort.Ints(values)
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) || !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("Divide() = %v, %v", keys, values)
+	}
+}
+
+func TestShuffleWith(t *testing.T) {
+	array := []int{1, 2, 3, 4, 5, 6}
+	first := ShuffleWith(rand.New(rand.NewSource(3)), array)
+	second := ShuffleWith(rand.New(rand.NewSource(3)), array)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("ShuffleWith() with same seed = %v and %v, want equal", first, second)
+	}
+}
@@ -0,0 +1,229 @@
+// Package lazy provides iterator based counterparts of the arr helpers.
+// Every function returns an iter.Seq or iter.Seq2 whose elements are only
+// computed when they are pulled by the consumer.
+package lazy
+
+import (
+	"iter"
+	"reflect"
+
+	"github.com/rulzi/helper-go/arr"
+)
+
+// Where filters the sequence using the given callback.
+func Where[T any](seq iter.Seq[T], callback func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if callback(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Where2 filters the key/value sequence using the given callback.
+func Where2[K, V any](seq iter.Seq2[K, V], callback func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if callback(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Reject filters the sequence using the negation of the given callback.
+func Reject[T any](seq iter.Seq[T], callback func(T) bool) iter.Seq[T] {
+	return Where(seq, func(item T) bool {
+		return !callback(item)
+	})
+}
+
+// Map runs a map over each of the items in the sequence.
+func Map[T, U any](seq iter.Seq[T], callback func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(callback(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 runs a map over each of the values in the key/value sequence, preserving keys.
+func Map2[K, V, U any](seq iter.Seq2[K, V], callback func(K, V) U) iter.Seq2[K, U] {
+	return func(yield func(K, U) bool) {
+		for k, v := range seq {
+			if !yield(k, callback(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Take takes the first or last {$limit} items from the sequence.
+// A negative limit has to consume the whole sequence before yielding and
+// only keeps the last {$limit} items in memory.
+func Take[T any](seq iter.Seq[T], limit int) iter.Seq[T] {
+	if limit < 0 {
+		return takeLast(seq, -limit)
+	}
+
+	return func(yield func(T) bool) {
+		if limit == 0 {
+			return
+		}
+
+		taken := 0
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+			taken++
+			if taken >= limit {
+				return
+			}
+		}
+	}
+}
+
+func takeLast[T any](seq iter.Seq[T], limit int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		buffer := make([]T, 0, limit)
+		next := 0
+		for item := range seq {
+			if len(buffer) < limit {
+				buffer = append(buffer, item)
+				continue
+			}
+			buffer[next] = item
+			next = (next + 1) % limit
+		}
+
+		for i := range buffer {
+			if !yield(buffer[(next+i)%len(buffer)]) {
+				return
+			}
+		}
+	}
+}
+
+// Flatten flattens a sequence of multi-dimensional values into a single level.
+// A depth of 0 flattens without limit, matching arr.Flatten.
+func Flatten(seq iter.Seq[interface{}], depth int) iter.Seq[interface{}] {
+	if depth == 0 {
+		depth = -1 // unlimited depth
+	}
+
+	return func(yield func(interface{}) bool) {
+		for item := range seq {
+			if !flattenYield(item, depth-1, yield) {
+				return
+			}
+		}
+	}
+}
+
+func flattenYield(item interface{}, depth int, yield func(interface{}) bool) bool {
+	if depth == 0 {
+		return yield(item)
+	}
+
+	val := reflect.ValueOf(item)
+	if !val.IsValid() {
+		return true
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if !flattenYield(val.Index(i).Interface(), depth-1, yield) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		entries := val.MapRange()
+		for entries.Next() {
+			if !flattenYield(entries.Value().Interface(), depth-1, yield) {
+				return false
+			}
+		}
+		return true
+	default:
+		return yield(item)
+	}
+}
+
+// Collapse collapses a sequence of slices into a single sequence.
+func Collapse[T any](seq iter.Seq[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for items := range seq {
+			for _, item := range items {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CrossJoin cross joins the given arrays, yielding every permutation one at a time.
+func CrossJoin[T any](arrays ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(arrays) == 0 {
+			return
+		}
+		for _, array := range arrays {
+			if len(array) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(arrays))
+		for {
+			product := make([]T, len(arrays))
+			for i, idx := range indices {
+				product[i] = arrays[i][idx]
+			}
+			if !yield(product) {
+				return
+			}
+
+			// Advance the rightmost index, carrying over like an odometer
+			pos := len(indices) - 1
+			for pos >= 0 {
+				indices[pos]++
+				if indices[pos] < len(arrays[pos]) {
+					break
+				}
+				indices[pos] = 0
+				pos--
+			}
+			if pos < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Pluck plucks the value at the given "dot" notation path from each item.
+// When key is empty the position of the item in the sequence is used as key.
+func Pluck(seq iter.Seq[map[string]interface{}], value string, key string) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		index := 0
+		for item := range seq {
+			itemValue := arr.Get(item, value, nil)
+
+			var itemKey interface{} = index
+			if key != "" {
+				itemKey = arr.Get(item, key, nil)
+			}
+			index++
+
+			if !yield(itemKey, itemValue) {
+				return
+			}
+		}
+	}
+}
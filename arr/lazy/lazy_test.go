@@ -0,0 +1,200 @@
+package lazy
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// naturals yields 1, 2, 3, ... without end and counts how many were pulled.
+func naturals(pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := 1; ; n++ {
+			*pulled++
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func TestWhere(t *testing.T) {
+	pulled := 0
+	even := Where(naturals(&pulled), func(n int) bool { return n%2 == 0 })
+	result := slices.Collect(Take(even, 3))
+
+	if !reflect.DeepEqual(result, []int{2, 4, 6}) {
+		t.Errorf("Where() = %v, want [2 4 6]", result)
+	}
+	if pulled != 6 {
+		t.Errorf("Where() pulled %d items, want 6", pulled)
+	}
+}
+
+func TestWhere2(t *testing.T) {
+	seq := maps.All(map[string]int{"a": 1, "b": 2, "c": 3})
+	result := maps.Collect(Where2(seq, func(k string, v int) bool { return v > 1 }))
+	expected := map[string]int{"b": 2, "c": 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Where2() = %v, want %v", result, expected)
+	}
+}
+
+func TestReject(t *testing.T) {
+	result := slices.Collect(Reject(slices.Values([]int{1, 2, 3, 4}), func(n int) bool { return n%2 == 0 }))
+	if !reflect.DeepEqual(result, []int{1, 3}) {
+		t.Errorf("Reject() = %v, want [1 3]", result)
+	}
+}
+
+func TestMap(t *testing.T) {
+	pulled := 0
+	calls := 0
+	doubled := Map(naturals(&pulled), func(n int) int {
+		calls++
+		return n * 2
+	})
+	result := slices.Collect(Take(doubled, 2))
+
+	if !reflect.DeepEqual(result, []int{2, 4}) {
+		t.Errorf("Map() = %v, want [2 4]", result)
+	}
+	if calls != 2 || pulled != 2 {
+		t.Errorf("Map() computed %d items from %d pulled, want 2 and 2", calls, pulled)
+	}
+}
+
+func TestMap2(t *testing.T) {
+	seq := maps.All(map[string]int{"a": 1, "b": 2})
+	result := maps.Collect(Map2(seq, func(k string, v int) string { return k + k }))
+	expected := map[string]string{"a": "aa", "b": "bb"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Map2() = %v, want %v", result, expected)
+	}
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []int
+		limit    int
+		expected []int
+	}{
+		{"take first", []int{1, 2, 3, 4}, 2, []int{1, 2}},
+		{"take last", []int{1, 2, 3, 4}, -2, []int{3, 4}},
+		{"take last wraps buffer", []int{1, 2, 3, 4, 5}, -3, []int{3, 4, 5}},
+		{"limit too large", []int{1, 2}, 5, []int{1, 2}},
+		{"negative too large", []int{1, 2}, -5, []int{1, 2}},
+		{"zero", []int{1, 2}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := slices.Collect(Take(slices.Values(tt.array), tt.limit))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Take() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		array    []interface{}
+		depth    int
+		expected []interface{}
+	}{
+		{
+			"unlimited",
+			[]interface{}{1, []interface{}{2, []interface{}{3, 4}}, 5},
+			0,
+			[]interface{}{1, 2, 3, 4, 5},
+		},
+		{
+			"depth one",
+			[]interface{}{1, []interface{}{2, []interface{}{3}}},
+			1,
+			[]interface{}{1, []interface{}{2, []interface{}{3}}},
+		},
+		{
+			"depth two",
+			[]interface{}{1, []interface{}{2, []interface{}{3}}},
+			2,
+			[]interface{}{1, 2, []interface{}{3}},
+		},
+		{
+			"skips nil",
+			[]interface{}{1, nil, []int{2}},
+			0,
+			[]interface{}{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := slices.Collect(Flatten(slices.Values(tt.array), tt.depth))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Flatten() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	seq := slices.Values([][]int{{1, 2}, {}, {3}})
+	result := slices.Collect(Collapse(seq))
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Collapse() = %v, want [1 2 3]", result)
+	}
+}
+
+func TestCrossJoin(t *testing.T) {
+	tests := []struct {
+		name     string
+		arrays   [][]int
+		expected [][]int
+	}{
+		{"two arrays", [][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		{"single array", [][]int{{1, 2}}, [][]int{{1}, {2}}},
+		{"empty member", [][]int{{1, 2}, {}}, nil},
+		{"no arrays", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := slices.Collect(CrossJoin(tt.arrays...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("CrossJoin() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		first := slices.Collect(Take(CrossJoin([]int{1, 2, 3}, []int{4, 5, 6}), 1))
+		if !reflect.DeepEqual(first, [][]int{{1, 4}}) {
+			t.Errorf("CrossJoin() first = %v, want [[1 4]]", first)
+		}
+	})
+}
+
+func TestPluck(t *testing.T) {
+	items := []map[string]interface{}{
+		{"id": 1, "user": map[string]interface{}{"name": "John"}},
+		{"id": 2, "user": map[string]interface{}{"name": "Jane"}},
+	}
+
+	byIndex := maps.Collect(Pluck(slices.Values(items), "user.name", ""))
+	expected := map[interface{}]interface{}{0: "John", 1: "Jane"}
+	if !reflect.DeepEqual(byIndex, expected) {
+		t.Errorf("Pluck() = %v, want %v", byIndex, expected)
+	}
+
+	byKey := maps.Collect(Pluck(slices.Values(items), "user.name", "id"))
+	expected = map[interface{}]interface{}{1: "John", 2: "Jane"}
+	if !reflect.DeepEqual(byKey, expected) {
+		t.Errorf("Pluck() with key = %v, want %v", byKey, expected)
+	}
+}
@@ -0,0 +1,319 @@
+package arr
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QueryFormat controls how slices are written into a query string.
+type QueryFormat int
+
+const (
+	// QueryBrackets writes slices as ids[]=1&ids[]=2.
+	QueryBrackets QueryFormat = iota
+	// QueryIndices writes slices as ids[0]=1&ids[1]=2.
+	QueryIndices
+	// QueryRepeat writes slices as ids=1&ids=2.
+	QueryRepeat
+	// QueryComma writes slices as ids=1,2.
+	QueryComma
+)
+
+// QueryWith converts the array into a query string, writing nested maps as
+// filter[status]=active and slices using the given format. Map keys are
+// written in sorted order and nil values are skipped. Slices that hold maps or
+// other slices always use indices so they can be parsed back.
+func QueryWith(array map[string]interface{}, format QueryFormat) string {
+	pairs := make([]string, 0, len(array))
+	for _, key := range sortedKeys(reflect.ValueOf(array)) {
+		name := toString(key.Interface())
+		pairs = appendQueryPairs(pairs, url.QueryEscape(name), array[name], format)
+	}
+	return strings.Join(pairs, "&")
+}
+
+func appendQueryPairs(pairs []string, prefix string, value interface{}, format QueryFormat) []string {
+	val := reflect.ValueOf(value)
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return pairs
+		}
+		val = val.Elem()
+	}
+	if !val.IsValid() {
+		return pairs
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		for _, key := range sortedKeys(val) {
+			name := url.QueryEscape(toString(key.Interface()))
+			pairs = appendQueryPairs(pairs, prefix+"["+name+"]", val.MapIndex(key).Interface(), format)
+		}
+		return pairs
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.Type().Elem().Kind() == reflect.Uint8 {
+			return append(pairs, prefix+"="+url.QueryEscape(string(val.Bytes())))
+		}
+
+		if !isScalarList(val) {
+			format = QueryIndices
+		}
+
+		if format == QueryComma {
+			if val.Len() == 0 {
+				return pairs
+			}
+			items := make([]string, val.Len())
+			for i := range items {
+				items[i] = url.QueryEscape(toString(val.Index(i).Interface()))
+			}
+			return append(pairs, prefix+"="+strings.Join(items, ","))
+		}
+
+		for i := 0; i < val.Len(); i++ {
+			key := prefix
+			switch format {
+			case QueryBrackets:
+				key += "[]"
+			case QueryIndices:
+				key += "[" + strconv.Itoa(i) + "]"
+			}
+			pairs = appendQueryPairs(pairs, key, val.Index(i).Interface(), format)
+		}
+		return pairs
+	default:
+		return append(pairs, prefix+"="+url.QueryEscape(toString(val.Interface())))
+	}
+}
+
+// isScalarList reports whether none of the items in the list are maps or lists.
+func isScalarList(val reflect.Value) bool {
+	for i := 0; i < val.Len(); i++ {
+		item := val.Index(i)
+		for item.Kind() == reflect.Interface || item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				break
+			}
+			item = item.Elem()
+		}
+		switch item.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			return false
+		}
+	}
+	return true
+}
+
+// sortedKeys returns the keys of a map ordered by their string representation.
+func sortedKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return toString(keys[i].Interface()) < toString(keys[j].Interface())
+	})
+	return keys
+}
+
+// ParseQuery parses a query string into a nested array. Bracketed keys such
+// as filter[status] create nested maps, ids[] and ids[0] create slices, and
+// a key that is repeated, such as ids=1&ids=2, collects its values into a
+// slice. All values are returned as strings.
+func ParseQuery(query string) (map[string]interface{}, error) {
+	return ParseQueryWith(query, QueryBrackets)
+}
+
+// ParseQueryWith parses a query string into a nested array. With QueryComma,
+// values are additionally split on commas into slices.
+func ParseQueryWith(query string, format QueryFormat) (map[string]interface{}, error) {
+	query = strings.TrimPrefix(query, "?")
+	result := make(map[string]interface{})
+	indexes := make(queryIndexes)
+
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+
+		rawKey, rawValue, _ := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query key %q: %w", rawKey, err)
+		}
+
+		var value interface{}
+		if format == QueryComma && strings.Contains(rawValue, ",") {
+			parts := strings.Split(rawValue, ",")
+			items := make(map[string]interface{}, len(parts))
+			for i, part := range parts {
+				item, err := url.QueryUnescape(part)
+				if err != nil {
+					return nil, fmt.Errorf("invalid query value %q: %w", rawValue, err)
+				}
+				items[strconv.Itoa(i)] = item
+			}
+			value = items
+		} else {
+			item, err := url.QueryUnescape(rawValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid query value %q: %w", rawValue, err)
+			}
+			value = item
+		}
+
+		if key == "" {
+			continue
+		}
+		insertQueryValue(result, parseQueryKey(key), value, indexes)
+	}
+
+	for k, v := range result {
+		result[k] = listify(v)
+	}
+	return result, nil
+}
+
+// parseQueryKey splits a key such as a[b][] into the segments "a", "b" and "".
+// A key with unbalanced brackets is used as a single literal segment.
+func parseQueryKey(key string) []string {
+	open := strings.IndexByte(key, '[')
+	if open <= 0 || !strings.HasSuffix(key, "]") {
+		return []string{key}
+	}
+
+	segments := []string{key[:open]}
+	rest := key[open:]
+	for rest != "" {
+		if rest[0] != '[' {
+			return []string{key}
+		}
+		end := strings.IndexByte(rest, ']')
+		if end == -1 {
+			return []string{key}
+		}
+		segments = append(segments, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	return segments
+}
+
+// insertQueryValue stores value at the given segments. Lists are built as maps
+// keyed by index and converted into slices afterwards by listify.
+func insertQueryValue(node map[string]interface{}, segments []string, value interface{}, indexes queryIndexes) {
+	for i, segment := range segments {
+		if segment == "" {
+			segment = strconv.Itoa(indexes.of(node).next)
+		}
+
+		if i == len(segments)-1 {
+			existing, exists := node[segment]
+			if exists && segments[i] != "" {
+				if _, isLeaf := existing.(string); isLeaf {
+					node[segment] = map[string]interface{}{"0": existing, "1": value}
+					return
+				}
+				if repeated, isMap := existing.(map[string]interface{}); isMap && indexes.of(repeated).isList(repeated) {
+					indexes.set(repeated, strconv.Itoa(len(repeated)), value)
+					return
+				}
+			}
+			indexes.set(node, segment, value)
+			return
+		}
+
+		child, ok := node[segment].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			indexes.set(node, segment, child)
+		}
+		node = child
+	}
+}
+
+// queryIndex counts the list keys of a node while a query is parsed.
+type queryIndex struct {
+	// One past the largest list key
+	next int
+	// Number of list keys
+	count int
+}
+
+// isList reports whether the keys of node are exactly 0..n-1.
+func (q *queryIndex) isList(node map[string]interface{}) bool {
+	return len(node) > 0 && q.count == len(node) && q.next == len(node)
+}
+
+// queryIndexes holds the queryIndex of each node by map pointer, so that
+// appending to a list does not scan its keys and parsing stays linear.
+type queryIndexes map[uintptr]*queryIndex
+
+// of returns the index of node, counting its keys the first time.
+func (q queryIndexes) of(node map[string]interface{}) *queryIndex {
+	ptr := reflect.ValueOf(node).Pointer()
+	if index, ok := q[ptr]; ok {
+		return index
+	}
+	index := &queryIndex{}
+	for k := range node {
+		index.add(k)
+	}
+	q[ptr] = index
+	return index
+}
+
+// set stores value under key in node, counting the key if it is new.
+func (q queryIndexes) set(node map[string]interface{}, key string, value interface{}) {
+	if _, exists := node[key]; !exists {
+		q.of(node).add(key)
+	}
+	node[key] = value
+}
+
+// add counts key if it is a list key, a number without leading zeros.
+func (q *queryIndex) add(key string) {
+	idx, err := strconv.Atoi(key)
+	if err != nil || idx < 0 || strconv.Itoa(idx) != key {
+		return
+	}
+	q.count++
+	q.next = max(q.next, idx+1)
+}
+
+// isList reports whether the keys of node are exactly 0..n-1.
+func isList(node map[string]interface{}) bool {
+	if len(node) == 0 {
+		return false
+	}
+	for i := 0; i < len(node); i++ {
+		if _, exists := node[strconv.Itoa(i)]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// listify converts maps keyed by 0..n-1 into slices, recursively.
+func listify(value interface{}) interface{} {
+	node, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	for k, v := range node {
+		node[k] = listify(v)
+	}
+
+	if !isList(node) {
+		return node
+	}
+
+	list := make([]interface{}, len(node))
+	for i := range list {
+		list[i] = node[strconv.Itoa(i)]
+	}
+	return list
+}
@@ -0,0 +1,208 @@
+package arr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQueryWith(t *testing.T) {
+	array := map[string]interface{}{
+		"filter": map[string]interface{}{"status": "active", "role": "admin"},
+		"ids":    []interface{}{1, 2},
+		"q":      "hello world",
+		"empty":  nil,
+	}
+
+	tests := []struct {
+		name     string
+		format   QueryFormat
+		expected string
+	}{
+		{"brackets", QueryBrackets, "filter[role]=admin&filter[status]=active&ids[]=1&ids[]=2&q=hello+world"},
+		{"indices", QueryIndices, "filter[role]=admin&filter[status]=active&ids[0]=1&ids[1]=2&q=hello+world"},
+		{"repeat", QueryRepeat, "filter[role]=admin&filter[status]=active&ids=1&ids=2&q=hello+world"},
+		{"comma", QueryComma, "filter[role]=admin&filter[status]=active&ids=1,2&q=hello+world"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := QueryWith(array, tt.format)
+			if result != tt.expected {
+				t.Errorf("QueryWith() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("nested slices of maps use indices", func(t *testing.T) {
+		result := QueryWith(map[string]interface{}{
+			"users": []interface{}{
+				map[string]interface{}{"name": "John"},
+				map[string]interface{}{"name": "Jane"},
+			},
+		}, QueryBrackets)
+		expected := "users[0][name]=John&users[1][name]=Jane"
+		if result != expected {
+			t.Errorf("QueryWith() = %q, want %q", result, expected)
+		}
+	})
+
+	t.Run("escapes keys and values", func(t *testing.T) {
+		result := QueryWith(map[string]interface{}{
+			"a&b": map[string]string{"c=d": "e&f"},
+		}, QueryBrackets)
+		expected := "a%26b[c%3Dd]=e%26f"
+		if result != expected {
+			t.Errorf("QueryWith() = %q, want %q", result, expected)
+		}
+	})
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{
+			"flat",
+			"a=1&b=hello+world",
+			map[string]interface{}{"a": "1", "b": "hello world"},
+			false,
+		},
+		{
+			"nested map and brackets",
+			"?filter[status]=active&ids[]=1&ids[]=2",
+			map[string]interface{}{
+				"filter": map[string]interface{}{"status": "active"},
+				"ids":    []interface{}{"1", "2"},
+			},
+			false,
+		},
+		{
+			"indices",
+			"users[0][name]=John&users[1][name]=Jane",
+			map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"name": "John"},
+					map[string]interface{}{"name": "Jane"},
+				},
+			},
+			false,
+		},
+		{
+			"repeated plain key",
+			"ids=1&ids=2&ids=3",
+			map[string]interface{}{"ids": []interface{}{"1", "2", "3"}},
+			false,
+		},
+		{
+			"sparse indices stay a map",
+			"a[1]=x&a[3]=y",
+			map[string]interface{}{"a": map[string]interface{}{"1": "x", "3": "y"}},
+			false,
+		},
+		{
+			"unbalanced brackets are literal",
+			"a[b=1",
+			map[string]interface{}{"a[b": "1"},
+			false,
+		},
+		{
+			"encoded brackets",
+			"filter%5Bstatus%5D=active",
+			map[string]interface{}{"filter": map[string]interface{}{"status": "active"}},
+			false,
+		},
+		{
+			"empty",
+			"",
+			map[string]interface{}{},
+			false,
+		},
+		{
+			"invalid escape",
+			"a=%zz",
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseQuery(tt.query)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("ParseQuery() expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseQuery() error = %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseQuery() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseQueryWith(t *testing.T) {
+	result, err := ParseQueryWith("ids=1,2,3&name=a%2Cb", QueryComma)
+	if err != nil {
+		t.Fatalf("ParseQueryWith() error = %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"ids":  []interface{}{"1", "2", "3"},
+		"name": "a,b",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ParseQueryWith() = %v, want %v", result, expected)
+	}
+}
+
+func TestQueryRoundTrip(t *testing.T) {
+	original := map[string]interface{}{
+		"filter": map[string]interface{}{"status": "active", "tags": []interface{}{"a", "b"}},
+		"ids":    []interface{}{"1", "2"},
+		"users": []interface{}{
+			map[string]interface{}{"name": "John Doe"},
+		},
+		"q": "x&y=z",
+	}
+
+	for _, format := range []QueryFormat{QueryBrackets, QueryIndices, QueryRepeat, QueryComma} {
+		result, err := ParseQueryWith(QueryWith(original, format), format)
+		if err != nil {
+			t.Fatalf("ParseQueryWith() error = %v", err)
+		}
+		if !reflect.DeepEqual(result, original) {
+			t.Errorf("format %d: round trip = %v, want %v", format, result, original)
+		}
+	}
+}
+
+func TestParseQueryLargeLists(t *testing.T) {
+	const n = 50000
+	query := strings.Repeat("a[]=1&", n) + strings.Repeat("b=2&", n) + strings.Repeat("c[x][]=3&", n)
+
+	start := time.Now()
+	result, err := ParseQuery(query)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("ParseQuery() error = %v", err)
+	}
+
+	for _, list := range []interface{}{result["a"], result["b"], Get(result, "c.x", nil)} {
+		if items, ok := list.([]interface{}); !ok || len(items) != n {
+			t.Fatalf("ParseQuery() list has %d items, want %d", len(items), n)
+		}
+	}
+	// Rescanning each list on append takes tens of seconds for this input
+	if elapsed > 2*time.Second {
+		t.Errorf("ParseQuery() of %d list items took %v", 3*n, elapsed)
+	}
+}
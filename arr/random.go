@@ -0,0 +1,76 @@
+package arr
+
+import (
+	"crypto/rand"
+	"math/big"
+	mathrand "math/rand"
+	"sync"
+)
+
+// Randomizer is a source of random integers used by Random and Shuffle.
+// *rand.Rand from math/rand satisfies it, so a seeded generator can be used
+// to make results reproducible in tests.
+type Randomizer interface {
+	// Intn returns a non-negative random number in [0, n).
+	Intn(n int) int
+}
+
+var (
+	randomMu     sync.RWMutex
+	randomSource Randomizer = defaultRandomizer{}
+)
+
+// defaultRandomizer uses the global math/rand generator.
+type defaultRandomizer struct{}
+
+func (defaultRandomizer) Intn(n int) int {
+	return mathrand.Intn(n)
+}
+
+// SecureRandomizer draws random numbers from crypto/rand.
+type SecureRandomizer struct{}
+
+// Intn returns a cryptographically secure random number in [0, n).
+func (SecureRandomizer) Intn(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic("failed to generate random number: " + err.Error())
+	}
+	return int(v.Int64())
+}
+
+// SetRandomSource sets the randomizer used by Random and Shuffle.
+// Passing nil restores the default math/rand source. The randomizer is shared
+// by all goroutines, so it must be safe for concurrent use if they call
+// Random or Shuffle at the same time; a *rand.Rand is not.
+func SetRandomSource(rng Randomizer) {
+	randomMu.Lock()
+	defer randomMu.Unlock()
+	if rng == nil {
+		rng = defaultRandomizer{}
+	}
+	randomSource = rng
+}
+
+// RandomSource returns the randomizer used by Random and Shuffle.
+func RandomSource() Randomizer {
+	randomMu.RLock()
+	defer randomMu.RUnlock()
+	return randomSource
+}
+
+// randomIndices returns number distinct indices in [0, n) in random order.
+func randomIndices(rng Randomizer, n, number int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	// Partial Fisher-Yates: only the first number positions are shuffled
+	for i := 0; i < number; i++ {
+		j := i + rng.Intn(n-i)
+		indices[i], indices[j] = indices[j], indices[i]
+	}
+
+	return indices[:number]
+}
@@ -0,0 +1,81 @@
+package arr
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRandomWith(t *testing.T) {
+	array := []interface{}{1, 2, 3, 4, 5}
+
+	first, err := RandomWith(rand.New(rand.NewSource(42)), array, 3, false)
+	if err != nil {
+		t.Fatalf("RandomWith() error = %v", err)
+	}
+	second, _ := RandomWith(rand.New(rand.NewSource(42)), array, 3, false)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("RandomWith() with same seed = %v and %v, want equal", first, second)
+	}
+
+	items := first.([]interface{})
+	seen := make(map[interface{}]bool)
+	for _, item := range items {
+		if seen[item] {
+			t.Errorf("RandomWith() returned duplicate %v", item)
+		}
+		seen[item] = true
+	}
+
+	if _, err := RandomWith(SecureRandomizer{}, array, 6, false); err == nil {
+		t.Errorf("RandomWith() expected error but got nil")
+	}
+}
+
+func TestShuffleWith(t *testing.T) {
+	array := []interface{}{1, 2, 3, 4, 5, 6, 7, 8}
+
+	first := ShuffleWith(rand.New(rand.NewSource(7)), array)
+	second := ShuffleWith(rand.New(rand.NewSource(7)), array)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("ShuffleWith() with same seed = %v and %v, want equal", first, second)
+	}
+
+	sorted := make([]int, len(first))
+	for i, v := range first {
+		sorted[i] = v.(int)
+	}
+	sort.Ints(sorted)
+	if !reflect.DeepEqual(sorted, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("ShuffleWith() = %v, not a permutation", first)
+	}
+}
+
+func TestSetRandomSource(t *testing.T) {
+	defer SetRandomSource(nil)
+
+	array := []interface{}{"a", "b", "c", "d"}
+
+	SetRandomSource(rand.New(rand.NewSource(1)))
+	first := Shuffle(array)
+	SetRandomSource(rand.New(rand.NewSource(1)))
+	second := Shuffle(array)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Shuffle() with pinned source = %v and %v, want equal", first, second)
+	}
+
+	SetRandomSource(nil)
+	if _, ok := RandomSource().(defaultRandomizer); !ok {
+		t.Errorf("SetRandomSource(nil) did not restore the default source")
+	}
+}
+
+func TestSecureRandomizer(t *testing.T) {
+	rng := SecureRandomizer{}
+	for i := 0; i < 100; i++ {
+		if n := rng.Intn(3); n < 0 || n >= 3 {
+			t.Fatalf("SecureRandomizer.Intn(3) = %d, out of range", n)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Package collection provides a fluent, chainable wrapper around slices
+// modelled on Laravel's Collection.
+package collection
+
+import (
+	"math"
+	"slices"
+
+	"github.com/rulzi/helper-go/arr/generic"
+)
+
+// Collection wraps a slice of items and exposes chainable helpers.
+// Methods that derive new items, such as Where, Map, Take and Chunk, return
+// new collections with slices of their own and leave the receiver untouched.
+// Pipe, Tap, When, Unless and Each return the receiver, or what the callback
+// returns, and Collect and All share the slice with the caller.
+type Collection[T any] struct {
+	items []T
+}
+
+// Collect creates a new collection from the given items.
+func Collect[T any](items []T) *Collection[T] {
+	return &Collection[T]{items: items}
+}
+
+// Make creates a new collection from the given variadic items.
+func Make[T any](items ...T) *Collection[T] {
+	return Collect(items)
+}
+
+// All returns the underlying slice of the collection.
+func (c *Collection[T]) All() []T {
+	return c.items
+}
+
+// Count returns the number of items in the collection.
+func (c *Collection[T]) Count() int {
+	return len(c.items)
+}
+
+// IsEmpty determines if the collection is empty.
+func (c *Collection[T]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// IsNotEmpty determines if the collection is not empty.
+func (c *Collection[T]) IsNotEmpty() bool {
+	return len(c.items) > 0
+}
+
+// Where filters the collection using the given callback.
+func (c *Collection[T]) Where(callback func(T) bool) *Collection[T] {
+	return Collect(generic.Where(c.items, callback))
+}
+
+// Reject filters the collection using the negation of the given callback.
+func (c *Collection[T]) Reject(callback func(T) bool) *Collection[T] {
+	return Collect(generic.Reject(c.items, callback))
+}
+
+// Map runs a map over each of the items. Use the package level Map
+// function to change the element type.
+func (c *Collection[T]) Map(callback func(T) T) *Collection[T] {
+	return Collect(generic.Map(c.items, callback))
+}
+
+// First returns the first item passing a given truth test.
+func (c *Collection[T]) First(callback func(T) bool, defaultValue T) T {
+	return generic.First(c.items, callback, defaultValue)
+}
+
+// Last returns the last item passing a given truth test.
+func (c *Collection[T]) Last(callback func(T) bool, defaultValue T) T {
+	return generic.Last(c.items, callback, defaultValue)
+}
+
+// Take takes the first or last {$limit} items.
+func (c *Collection[T]) Take(limit int) *Collection[T] {
+	return Collect(generic.Take(c.items, limit))
+}
+
+// Sort sorts the collection using the given callback, which is required.
+func (c *Collection[T]) Sort(callback func(T, T) bool) *Collection[T] {
+	return Collect(generic.Sort(c.items, callback))
+}
+
+// SortDesc sorts the collection in descending order using the given
+// callback, which is required.
+func (c *Collection[T]) SortDesc(callback func(T, T) bool) *Collection[T] {
+	return Collect(generic.SortDesc(c.items, callback))
+}
+
+// Shuffle shuffles the items in the collection.
+func (c *Collection[T]) Shuffle() *Collection[T] {
+	return Collect(generic.Shuffle(c.items))
+}
+
+// Values returns a new collection holding a copy of the items.
+func (c *Collection[T]) Values() *Collection[T] {
+	items := make([]T, len(c.items))
+	copy(items, c.items)
+	return Collect(items)
+}
+
+// GroupBy groups the items by the key returned from the given callback.
+func (c *Collection[T]) GroupBy(callback func(T) string) map[string]*Collection[T] {
+	return GroupBy(c, callback)
+}
+
+// Chunk breaks the collection into multiple, smaller collections of a given size.
+func (c *Collection[T]) Chunk(size int) []*Collection[T] {
+	if size <= 0 {
+		return []*Collection[T]{}
+	}
+
+	chunks := make([]*Collection[T], 0, (len(c.items)+size-1)/size)
+	for start := 0; start < len(c.items); start += size {
+		end := min(start+size, len(c.items))
+		chunks = append(chunks, Collect(slices.Clone(c.items[start:end])))
+	}
+
+	return chunks
+}
+
+// Partition separates the items that pass the given truth test from those that do not.
+func (c *Collection[T]) Partition(callback func(T) bool) (*Collection[T], *Collection[T]) {
+	passed := make([]T, 0, len(c.items))
+	failed := make([]T, 0, len(c.items))
+
+	for _, item := range c.items {
+		if callback(item) {
+			passed = append(passed, item)
+		} else {
+			failed = append(failed, item)
+		}
+	}
+
+	return Collect(passed), Collect(failed)
+}
+
+// Unique returns only the unique items. When key is nil the items themselves
+// are compared, which requires them to be comparable.
+func (c *Collection[T]) Unique(key func(T) any) *Collection[T] {
+	seen := make(map[any]struct{}, len(c.items))
+	result := make([]T, 0, len(c.items))
+
+	for _, item := range c.items {
+		var k any = item
+		if key != nil {
+			k = key(item)
+		}
+		if _, exists := seen[k]; exists {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, item)
+	}
+
+	return Collect(result)
+}
+
+// Sum returns the sum of the values returned from the given callback.
+func (c *Collection[T]) Sum(callback func(T) float64) float64 {
+	var sum float64
+	for _, item := range c.items {
+		sum += callback(item)
+	}
+	return sum
+}
+
+// Avg returns the average of the values returned from the given callback.
+func (c *Collection[T]) Avg(callback func(T) float64) float64 {
+	if len(c.items) == 0 {
+		return 0
+	}
+	return c.Sum(callback) / float64(len(c.items))
+}
+
+// Min returns the minimum of the values returned from the given callback.
+func (c *Collection[T]) Min(callback func(T) float64) float64 {
+	if len(c.items) == 0 {
+		return 0
+	}
+
+	result := math.Inf(1)
+	for _, item := range c.items {
+		result = math.Min(result, callback(item))
+	}
+	return result
+}
+
+// Max returns the maximum of the values returned from the given callback.
+func (c *Collection[T]) Max(callback func(T) float64) float64 {
+	if len(c.items) == 0 {
+		return 0
+	}
+
+	result := math.Inf(-1)
+	for _, item := range c.items {
+		result = math.Max(result, callback(item))
+	}
+	return result
+}
+
+// Pipe passes the collection to the given callback and returns the result.
+func (c *Collection[T]) Pipe(callback func(*Collection[T]) *Collection[T]) *Collection[T] {
+	return callback(c)
+}
+
+// Tap passes the collection to the given callback and then returns the collection.
+func (c *Collection[T]) Tap(callback func(*Collection[T])) *Collection[T] {
+	callback(c)
+	return c
+}
+
+// When applies the callback if the given condition is true.
+func (c *Collection[T]) When(condition bool, callback func(*Collection[T]) *Collection[T]) *Collection[T] {
+	if condition {
+		return callback(c)
+	}
+	return c
+}
+
+// Unless applies the callback if the given condition is false.
+func (c *Collection[T]) Unless(condition bool, callback func(*Collection[T]) *Collection[T]) *Collection[T] {
+	return c.When(!condition, callback)
+}
+
+// Each executes a callback over each item. Returning false from the callback
+// stops the iteration.
+func (c *Collection[T]) Each(callback func(T, int) bool) *Collection[T] {
+	for i, item := range c.items {
+		if !callback(item, i) {
+			break
+		}
+	}
+	return c
+}
+
+// Reduce reduces the collection to a single value of the element type. Use the
+// package level Reduce function to reduce into a different type.
+func (c *Collection[T]) Reduce(callback func(T, T) T, initial T) T {
+	return Reduce(c, callback, initial)
+}
+
+// Map runs a map over each of the items, returning a collection of a new type.
+func Map[T, U any](c *Collection[T], callback func(T) U) *Collection[U] {
+	return Collect(generic.Map(c.items, callback))
+}
+
+// Reduce reduces the collection to a single value.
+func Reduce[T, A any](c *Collection[T], callback func(A, T) A, initial A) A {
+	carry := initial
+	for _, item := range c.items {
+		carry = callback(carry, item)
+	}
+	return carry
+}
+
+// Pipe passes the collection to the given callback and returns the result.
+func Pipe[T, U any](c *Collection[T], callback func(*Collection[T]) U) U {
+	return callback(c)
+}
+
+// GroupBy groups the items by the key returned from the given callback.
+func GroupBy[T any, K comparable](c *Collection[T], callback func(T) K) map[K]*Collection[T] {
+	groups := make(map[K][]T)
+	for _, item := range c.items {
+		key := callback(item)
+		groups[key] = append(groups[key], item)
+	}
+
+	result := make(map[K]*Collection[T], len(groups))
+	for key, items := range groups {
+		result[key] = Collect(items)
+	}
+	return result
+}
+
+// KeyBy keys the items by the value returned from the given callback.
+func KeyBy[T any, K comparable](c *Collection[T], callback func(T) K) map[K]T {
+	return generic.KeyBy(c.items, callback)
+}
@@ -0,0 +1,276 @@
+package collection
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type product struct {
+	Name     string
+	Category string
+	Price    float64
+}
+
+var products = []product{
+	{"Desk", "furniture", 200},
+	{"Chair", "furniture", 100},
+	{"Lamp", "lighting", 50},
+	{"Bulb", "lighting", 5},
+}
+
+func TestCollect(t *testing.T) {
+	c := Collect([]int{1, 2, 3})
+	if c.Count() != 3 || !reflect.DeepEqual(c.All(), []int{1, 2, 3}) {
+		t.Errorf("Collect() = %v", c.All())
+	}
+
+	if !Make[int]().IsEmpty() || Make(1).IsEmpty() || !Make(1).IsNotEmpty() {
+		t.Errorf("Make() emptiness checks failed")
+	}
+}
+
+func TestChaining(t *testing.T) {
+	result := Make(5, 3, 8, 1, 9, 2).
+		Where(func(n int) bool { return n > 2 }).
+		Map(func(n int) int { return n * 10 }).
+		Sort(func(a, b int) bool { return a < b }).
+		Take(3).
+		All()
+
+	expected := []int{30, 50, 80}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("chain = %v, want %v", result, expected)
+	}
+}
+
+func TestDerivedCollectionsOwnTheirItems(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	c := Collect(items)
+
+	taken := c.Take(2).All()
+	taken[0] = 100
+	last := c.Take(-2).All()
+	last[0] = 300
+	chunk := c.Chunk(2)[1].All()
+	chunk[1] = 400
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 4}) {
+		t.Errorf("derived collections changed the items to %v", items)
+	}
+
+	if c.Tap(func(*Collection[int]) {}) != c || c.When(false, nil) != c || c.Each(func(int, int) bool { return true }) != c {
+		t.Error("Tap, When and Each did not return the receiver")
+	}
+}
+
+func TestWhereReject(t *testing.T) {
+	c := Make(1, 2, 3, 4)
+	even := func(n int) bool { return n%2 == 0 }
+
+	if got := c.Where(even).All(); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Where() = %v", got)
+	}
+	if got := c.Reject(even).All(); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("Reject() = %v", got)
+	}
+	if !reflect.DeepEqual(c.All(), []int{1, 2, 3, 4}) {
+		t.Errorf("receiver modified: %v", c.All())
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	c := Make(1, 2, 3, 4)
+	if got := c.First(func(n int) bool { return n > 1 }, 0); got != 2 {
+		t.Errorf("First() = %v, want 2", got)
+	}
+	if got := c.Last(func(n int) bool { return n < 4 }, 0); got != 3 {
+		t.Errorf("Last() = %v, want 3", got)
+	}
+	if got := Make[int]().First(nil, -1); got != -1 {
+		t.Errorf("First() on empty = %v, want -1", got)
+	}
+}
+
+func TestSortDescShuffleValues(t *testing.T) {
+	c := Make(2, 3, 1)
+	if got := c.SortDesc(func(a, b int) bool { return a < b }).All(); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("SortDesc() = %v", got)
+	}
+	if got := c.Shuffle().Count(); got != 3 {
+		t.Errorf("Shuffle() count = %d, want 3", got)
+	}
+
+	values := c.Values()
+	values.All()[0] = 99
+	if c.All()[0] != 2 {
+		t.Errorf("Values() shares the underlying slice")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := Collect(products).GroupBy(func(p product) string { return p.Category })
+	if len(groups) != 2 {
+		t.Fatalf("GroupBy() groups = %d, want 2", len(groups))
+	}
+	if groups["furniture"].Count() != 2 || groups["lighting"].First(nil, product{}).Name != "Lamp" {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+
+	byExpensive := GroupBy(Collect(products), func(p product) bool { return p.Price >= 100 })
+	if byExpensive[true].Count() != 2 || byExpensive[false].Count() != 2 {
+		t.Errorf("GroupBy() with bool key = %v", byExpensive)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		size     int
+		expected [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"larger than items", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"zero size", []int{1, 2}, 0, [][]int{}},
+		{"empty", []int{}, 2, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := [][]int{}
+			for _, chunk := range Collect(tt.items).Chunk(tt.size) {
+				result = append(result, chunk.All())
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Chunk() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	passed, failed := Make(1, 2, 3, 4, 5).Partition(func(n int) bool { return n > 2 })
+	if !reflect.DeepEqual(passed.All(), []int{3, 4, 5}) || !reflect.DeepEqual(failed.All(), []int{1, 2}) {
+		t.Errorf("Partition() = %v, %v", passed.All(), failed.All())
+	}
+}
+
+func TestUnique(t *testing.T) {
+	if got := Make(1, 2, 2, 3, 1).Unique(nil).All(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Unique() = %v", got)
+	}
+
+	byCategory := Collect(products).Unique(func(p product) any { return p.Category })
+	if byCategory.Count() != 2 {
+		t.Errorf("Unique() by key = %v", byCategory.All())
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	c := Collect(products)
+	price := func(p product) float64 { return p.Price }
+
+	tests := []struct {
+		name     string
+		result   float64
+		expected float64
+	}{
+		{"sum", c.Sum(price), 355},
+		{"avg", c.Avg(price), 88.75},
+		{"min", c.Min(price), 5},
+		{"max", c.Max(price), 200},
+		{"empty avg", Make[product]().Avg(price), 0},
+		{"empty min", Make[product]().Min(price), 0},
+		{"empty max", Make[product]().Max(price), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPipeTap(t *testing.T) {
+	tapped := 0
+	result := Make(1, 2, 3).
+		Tap(func(c *Collection[int]) { tapped = c.Count() }).
+		Pipe(func(c *Collection[int]) *Collection[int] { return c.Take(1) })
+
+	if tapped != 3 || !reflect.DeepEqual(result.All(), []int{1}) {
+		t.Errorf("Tap()/Pipe() = %d, %v", tapped, result.All())
+	}
+
+	total := Pipe(Make(1, 2, 3), func(c *Collection[int]) int { return c.Count() })
+	if total != 3 {
+		t.Errorf("Pipe() = %d, want 3", total)
+	}
+}
+
+func TestWhenUnless(t *testing.T) {
+	double := func(c *Collection[int]) *Collection[int] {
+		return c.Map(func(n int) int { return n * 2 })
+	}
+
+	tests := []struct {
+		name     string
+		result   []int
+		expected []int
+	}{
+		{"when true", Make(1, 2).When(true, double).All(), []int{2, 4}},
+		{"when false", Make(1, 2).When(false, double).All(), []int{1, 2}},
+		{"unless true", Make(1, 2).Unless(true, double).All(), []int{1, 2}},
+		{"unless false", Make(1, 2).Unless(false, double).All(), []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.result, tt.expected) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEach(t *testing.T) {
+	var visited []int
+	Make(1, 2, 3, 4).Each(func(n int, i int) bool {
+		visited = append(visited, n)
+		return n < 2
+	})
+
+	if !reflect.DeepEqual(visited, []int{1, 2}) {
+		t.Errorf("Each() visited %v, want [1 2]", visited)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	if got := Make(1, 2, 3).Reduce(func(carry, n int) int { return carry + n }, 10); got != 16 {
+		t.Errorf("Reduce() = %d, want 16", got)
+	}
+
+	joined := Reduce(Make(1, 2, 3), func(carry string, n int) string {
+		return carry + strconv.Itoa(n)
+	}, "")
+	if joined != "123" {
+		t.Errorf("Reduce() = %q, want %q", joined, "123")
+	}
+}
+
+func TestMap(t *testing.T) {
+	names := Map(Collect(products), func(p product) string { return p.Name }).All()
+	expected := []string{"Desk", "Chair", "Lamp", "Bulb"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Map() = %v, want %v", names, expected)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	keyed := KeyBy(Collect(products), func(p product) string { return p.Name })
+	if len(keyed) != 4 || keyed["Lamp"].Price != 50 {
+		t.Errorf("KeyBy() = %v", keyed)
+	}
+}
@@ -0,0 +1,24 @@
+// Package langtag holds the language tag helpers shared by the number and str
+// packages.
+package langtag
+
+import "golang.org/x/text/language"
+
+// Lookup returns the entry of a registry keyed by language tag for the given
+// tag, trying the tag itself, its CLDR parents such as "es-419" for "es-MX",
+// and then its base language. An undetermined tag finds nothing.
+func Lookup[T any](registry map[string]T, tag language.Tag) (T, bool) {
+	for t := tag; t != language.Und; t = t.Parent() {
+		if entry, ok := registry[t.String()]; ok {
+			return entry, true
+		}
+	}
+	// The base of an undetermined tag is a guess, such as "en"
+	if base, confidence := tag.Base(); confidence != language.No && tag != language.Und {
+		if entry, ok := registry[base.String()]; ok {
+			return entry, true
+		}
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,37 @@
+package langtag
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestLookup(t *testing.T) {
+	registry := map[string]string{"en": "en", "es": "es", "es-419": "es-419", "zh": "zh", "pt-PT": "pt-PT"}
+
+	tests := []struct {
+		name     string
+		tag      string
+		expected string
+		found    bool
+	}{
+		{"exact tag", "es-419", "es-419", true},
+		{"cldr parent", "es-MX", "es-419", true},
+		{"base language", "es-ES", "es", true},
+		{"parent of a parent", "pt-AO", "pt-PT", true},
+		{"base after script parent", "zh-TW", "zh", true},
+		{"missing", "fr-CA", "", false},
+		{"undetermined", "und", "", false},
+		{"empty", "", "", false},
+		{"invalid", "xx", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, found := Lookup(registry, language.Make(tt.tag))
+			if result != tt.expected || found != tt.found {
+				t.Errorf("Lookup(%q) = %q, %v, want %q, %v", tt.tag, result, found, tt.expected, tt.found)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package number
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rulzi/helper-go/internal/langtag"
+	"golang.org/x/text/currency"
+)
+
+// CurrencyInfo describes a currency that is not in ISO 4217, such as a
+// cryptocurrency or loyalty points, or overrides the data of one that is.
+type CurrencyInfo struct {
+	// Code identifies the currency, such as "BTC" or "POINTS".
+	Code string
+	// Symbol is the standard symbol, such as "₿". The code is used when empty.
+	Symbol string
+	// NarrowSymbol is the short symbol. The standard symbol is used when empty.
+	NarrowSymbol string
+	// MinorUnits is the number of fraction digits, such as 8 for BTC.
+	MinorUnits int
+}
+
+// NegativeStyle controls how negative currency amounts are written.
+type NegativeStyle int
+
+const (
+	// NegativeSign puts the minus sign in front, as in -$5.00 or -5,00 €.
+	NegativeSign NegativeStyle = iota
+	// NegativeParentheses wraps the amount in parentheses, as in ($5.00).
+	NegativeParentheses
+	// NegativeAfterSymbol puts the minus sign between a leading symbol and
+	// the number, as in € -5,00.
+	NegativeAfterSymbol
+)
+
+// CurrencyPattern describes where a locale puts the currency symbol.
+type CurrencyPattern struct {
+	// SymbolAfter puts the symbol after the number, as in 5,00 €.
+	SymbolAfter bool
+	// Space separates the symbol from the number with a no-break space.
+	Space bool
+	// Negative is the style used for negative amounts.
+	Negative NegativeStyle
+}
+
+var (
+	// Mutex for thread-safe access to the currency registries
+	currenciesMu sync.RWMutex
+
+	// Custom currencies by code
+	customCurrencies = map[string]CurrencyInfo{}
+
+	// Currency patterns by language tag, looked up with langtag.Lookup; other
+	// locales put the symbol first
+	currencyPatterns = map[string]CurrencyPattern{
+		"id":     {Space: true},
+		"nl":     {Space: true, Negative: NegativeAfterSymbol},
+		"pt":     {Space: true},
+		"de-AT":  {Space: true},
+		"de-CH":  {Space: true},
+		"de-LI":  {Space: true},
+		"es-419": {},
+		"es-AR":  {Space: true},
+		"es-CL":  {Negative: NegativeAfterSymbol},
+		"es-CO":  {Space: true},
+		"bg":     {SymbolAfter: true, Space: true},
+		"cs":     {SymbolAfter: true, Space: true},
+		"da":     {SymbolAfter: true, Space: true},
+		"de":     {SymbolAfter: true, Space: true},
+		"el":     {SymbolAfter: true, Space: true},
+		"es":     {SymbolAfter: true, Space: true},
+		"et":     {SymbolAfter: true, Space: true},
+		"fi":     {SymbolAfter: true, Space: true},
+		"fr":     {SymbolAfter: true, Space: true},
+		"hr":     {SymbolAfter: true, Space: true},
+		"hu":     {SymbolAfter: true, Space: true},
+		"it":     {SymbolAfter: true, Space: true},
+		"lt":     {SymbolAfter: true, Space: true},
+		"lv":     {SymbolAfter: true, Space: true},
+		"nb":     {SymbolAfter: true, Space: true},
+		"pl":     {SymbolAfter: true, Space: true},
+		"pt-PT":  {SymbolAfter: true, Space: true},
+		"ro":     {SymbolAfter: true, Space: true},
+		"ru":     {SymbolAfter: true, Space: true},
+		"sk":     {SymbolAfter: true, Space: true},
+		"sl":     {SymbolAfter: true, Space: true},
+		"sv":     {SymbolAfter: true, Space: true},
+		"uk":     {SymbolAfter: true, Space: true},
+		"vi":     {SymbolAfter: true, Space: true},
+	}
+
+	// Symbols and codes of the custom currencies, longest first
+	customCandidates []currencyCandidate
+
+	// ISO 4217 symbols by canonical language tag, longest first, collected on
+	// first use for parsing
+	isoCurrencySymbols sync.Map
+)
+
+// maxMinorUnits is the most fraction digits a currency can have, so that the
+// minor units of one major unit fit in a uint64.
+const maxMinorUnits = 18
+
+// RegisterCurrency registers a custom currency, or overrides the symbols and
+// minor units of an ISO 4217 currency. It returns an error when MinorUnits is
+// outside 0 to 18.
+func RegisterCurrency(info CurrencyInfo) error {
+	if info.MinorUnits < 0 || info.MinorUnits > maxMinorUnits {
+		return fmt.Errorf("invalid minor units %d for currency %q: must be between 0 and %d", info.MinorUnits, info.Code, maxMinorUnits)
+	}
+	info.Code = strings.ToUpper(info.Code)
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	customCurrencies[info.Code] = info
+	updateCustomCandidates()
+	return nil
+}
+
+// updateCustomCandidates rebuilds customCandidates from customCurrencies. The
+// caller must hold currenciesMu for writing.
+func updateCustomCandidates() {
+	candidates := make([]currencyCandidate, 0, 3*len(customCurrencies))
+	for code, info := range customCurrencies {
+		candidates = append(candidates,
+			currencyCandidate{code, code, true},
+			currencyCandidate{info.Symbol, code, true},
+			currencyCandidate{info.NarrowSymbol, code, false},
+		)
+	}
+	customCandidates = sortCandidates(candidates)
+}
+
+// RegisterCurrencyPattern registers the currency pattern used for the given
+// locale. A pattern registered for a locale such as "nl" or "es-419" is also
+// used for the locales that inherit from it in CLDR, such as "nl-BE" or
+// "es-MX", unless one of them has a pattern of its own.
+func RegisterCurrencyPattern(locale string, pattern CurrencyPattern) {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	currencyPatterns[localeTag(locale).String()] = pattern
+}
+
+// CurrencySymbol returns the symbol of the currency in the given locale, such
+// as "$" for USD in English and "US$" in Indonesian. The narrow symbol drops
+// the country, as in "$" for AUD. Unknown currencies use their code.
+func CurrencySymbol(code string, locale *string, narrow bool) string {
+	loc := localeOrDefault(locale)
+	return currencySymbol(strings.ToUpper(code), loc, narrow)
+}
+
+func currencySymbol(code, locale string, narrow bool) string {
+	currenciesMu.RLock()
+	info, custom := customCurrencies[code]
+	currenciesMu.RUnlock()
+
+	if custom {
+		switch {
+		case narrow && info.NarrowSymbol != "":
+			return info.NarrowSymbol
+		case info.Symbol != "":
+			return info.Symbol
+		}
+		return info.Code
+	}
+
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return code
+	}
+	if narrow {
+		return printer(locale).Sprint(currency.NarrowSymbol(unit))
+	}
+	return printer(locale).Sprint(currency.Symbol(unit))
+}
+
+// MinorUnits returns the number of fraction digits of the currency, such as 2
+// for USD, 0 for JPY and 3 for BHD. Unknown currencies use 2.
+func MinorUnits(code string) int {
+	code = strings.ToUpper(code)
+
+	currenciesMu.RLock()
+	info, custom := customCurrencies[code]
+	currenciesMu.RUnlock()
+	if custom {
+		return info.MinorUnits
+	}
+
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 2
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale
+}
+
+// currencyPatternFor returns the currency pattern for the locale, falling back
+// to a leading symbol.
+func currencyPatternFor(locale string) CurrencyPattern {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	pattern, _ := langtag.Lookup(currencyPatterns, localeTag(locale))
+	return pattern
+}
+
+// formatCurrency places the currency symbol and the sign around an amount
+// that is already formatted for the locale. As in CLDR, a symbol that ends in
+// a letter next to the number is always separated by a no-break space.
+func formatCurrency(formatted string, negative bool, code, locale string) string {
+	pattern := currencyPatternFor(locale)
+	symbol := currencySymbol(code, locale, false)
+
+	separator := ""
+	if pattern.Space || symbolTouchesLetter(symbol, pattern.SymbolAfter) {
+		separator = "\u00a0"
+	}
+
+	sign := ""
+	if negative && pattern.Negative != NegativeParentheses {
+		sign = minusSign(locale)
+	}
+
+	var result string
+	switch {
+	case pattern.SymbolAfter:
+		result = sign + formatted + separator + symbol
+	case pattern.Negative == NegativeAfterSymbol:
+		result = symbol + separator + sign + formatted
+	default:
+		result = sign + symbol + separator + formatted
+	}
+
+	if negative && pattern.Negative == NegativeParentheses {
+		return "(" + result + ")"
+	}
+	return result
+}
+
+// symbolTouchesLetter reports whether the side of symbol next to the number is
+// a letter, as in "IDR" or "CHF".
+func symbolTouchesLetter(symbol string, after bool) bool {
+	var r rune
+	if after {
+		r, _ = utf8.DecodeRuneInString(symbol)
+	} else {
+		r, _ = utf8.DecodeLastRuneInString(symbol)
+	}
+	return unicode.IsLetter(r)
+}
+
+// currencyCandidate is a text that identifies a currency in formatted amounts.
+type currencyCandidate struct {
+	text     string
+	code     string
+	standard bool
+}
+
+// currencyCandidates returns the symbols and codes of every known currency in
+// the locale, longest first. Custom currencies come before ISO 4217 ones of
+// the same length and replace those with the same code.
+func currencyCandidates(locale string) []currencyCandidate {
+	key := localeKey(locale)
+	var candidates []currencyCandidate
+	if cached, ok := isoCurrencySymbols.Load(key); ok {
+		candidates = cached.([]currencyCandidate)
+	} else {
+		seen := make(map[string]bool)
+		for it := currency.Query(); it.Next(); {
+			code := it.Unit().String()
+			if seen[code] {
+				continue
+			}
+			seen[code] = true
+			candidates = append(candidates,
+				currencyCandidate{code, code, true},
+				currencyCandidate{printer(locale).Sprint(currency.Symbol(it.Unit())), code, true},
+				currencyCandidate{printer(locale).Sprint(currency.NarrowSymbol(it.Unit())), code, false},
+			)
+		}
+		candidates = sortCandidates(candidates)
+		isoCurrencySymbols.Store(key, candidates)
+	}
+
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	// Merge the two sorted lists, keeping them longest first
+	all := make([]currencyCandidate, 0, len(candidates)+len(customCandidates))
+	custom := customCandidates
+	for _, c := range candidates {
+		if _, overridden := customCurrencies[c.code]; overridden {
+			continue
+		}
+		for len(custom) > 0 && len(custom[0].text) >= len(c.text) {
+			all = append(all, custom[0])
+			custom = custom[1:]
+		}
+		all = append(all, c)
+	}
+	return append(all, custom...)
+}
+
+// sortCandidates drops empty texts and sorts the candidates longest first.
+func sortCandidates(candidates []currencyCandidate) []currencyCandidate {
+	candidates = slices.DeleteFunc(candidates, func(c currencyCandidate) bool { return c.text == "" })
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].text) > len(candidates[j].text)
+	})
+	return candidates
+}
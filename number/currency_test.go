@@ -0,0 +1,136 @@
+package number
+
+import "testing"
+
+func TestCurrencySymbol(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		locale   *string
+		narrow   bool
+		expected string
+	}{
+		{"dollar", "USD", nil, false, "$"},
+		{"lowercase code", "eur", nil, false, "€"},
+		{"localized", "USD", stringPtr("id"), false, "US$"},
+		{"rupiah in english", "IDR", nil, false, "IDR"},
+		{"narrow rupiah", "IDR", nil, true, "Rp"},
+		{"narrow australian dollar", "AUD", nil, true, "$"},
+		{"unknown", "XYZ", nil, false, "XYZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := CurrencySymbol(tt.code, tt.locale, tt.narrow); result != tt.expected {
+				t.Errorf("CurrencySymbol(%q, %v, %v) = %q, want %q", tt.code, tt.locale, tt.narrow, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	defer func() {
+		currenciesMu.Lock()
+		delete(customCurrencies, "BTC")
+		delete(customCurrencies, "POINTS")
+		updateCustomCandidates()
+		currenciesMu.Unlock()
+	}()
+
+	if err := RegisterCurrency(CurrencyInfo{Code: "btc", Symbol: "₿", MinorUnits: 8}); err != nil {
+		t.Fatalf("RegisterCurrency(BTC) error = %v", err)
+	}
+	if err := RegisterCurrency(CurrencyInfo{Code: "POINTS", MinorUnits: 0}); err != nil {
+		t.Fatalf("RegisterCurrency(POINTS) error = %v", err)
+	}
+
+	if result := Currency(0.5, "BTC", nil, nil); result != "₿0.50000000" {
+		t.Errorf("Currency(0.5, BTC) = %q, want %q", result, "₿0.50000000")
+	}
+	if result := NewMoney(150000000, "BTC").Format(nil); result != "₿1.50000000" {
+		t.Errorf("Money.Format() = %q, want %q", result, "₿1.50000000")
+	}
+	if result := Currency(1200, "POINTS", nil, nil); result != "POINTS 1,200" {
+		t.Errorf("Currency(1200, POINTS) = %q, want %q", result, "POINTS 1,200")
+	}
+	if amount, code, err := ParseCurrency("₿1.5", nil); err != nil || amount != 1.5 || code != "BTC" {
+		t.Errorf("ParseCurrency(%q) = %v, %q, %v", "₿1.5", amount, code, err)
+	}
+	if amount, code, err := ParseCurrency("POINTS 1,200", nil); err != nil || amount != 1200 || code != "POINTS" {
+		t.Errorf("ParseCurrency(%q) = %v, %q, %v", "POINTS 1,200", amount, code, err)
+	}
+	if amount, code, err := ParseCurrency("$5", nil); err != nil || amount != 5 || code != "USD" {
+		t.Errorf("ParseCurrency(%q) = %v, %q, %v", "$5", amount, code, err)
+	}
+}
+
+func TestRegisterCurrencyInvalidMinorUnits(t *testing.T) {
+	tests := []struct {
+		name       string
+		minorUnits int
+	}{
+		{"negative", -1},
+		{"very negative", -100},
+		{"above uint64 range", 19},
+		{"far above uint64 range", 308},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterCurrency(CurrencyInfo{Code: "BAD", MinorUnits: tt.minorUnits}); err == nil {
+				t.Errorf("RegisterCurrency(MinorUnits: %d) returned no error", tt.minorUnits)
+			}
+
+			currenciesMu.RLock()
+			_, registered := customCurrencies["BAD"]
+			currenciesMu.RUnlock()
+			if registered {
+				t.Errorf("RegisterCurrency(MinorUnits: %d) registered the currency", tt.minorUnits)
+			}
+			// Money in an unregistered currency uses the default minor units
+			if result := NewMoney(150, "BAD").String(); result != "1.50 BAD" {
+				t.Errorf("Money.String() = %q, want %q", result, "1.50 BAD")
+			}
+		})
+	}
+
+	defer func() {
+		currenciesMu.Lock()
+		delete(customCurrencies, "MAX")
+		updateCustomCandidates()
+		currenciesMu.Unlock()
+	}()
+	if err := RegisterCurrency(CurrencyInfo{Code: "MAX", MinorUnits: 18}); err != nil {
+		t.Fatalf("RegisterCurrency(MinorUnits: 18) error = %v", err)
+	}
+	if result := NewMoney(1, "MAX").Format(nil); result == "" {
+		t.Error("Money.Format() with 18 minor units is empty")
+	}
+}
+
+func TestRegisterCurrencyPattern(t *testing.T) {
+	defer func() {
+		currenciesMu.Lock()
+		delete(currencyPatterns, "en-US")
+		currenciesMu.Unlock()
+	}()
+
+	RegisterCurrencyPattern("en_US", CurrencyPattern{Negative: NegativeParentheses})
+
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{"registered locale", "en-US", "($5.00)"},
+		{"other variant unchanged", "en-GB", "-US$5.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Currency(-5, "USD", &tt.locale, nil); result != tt.expected {
+				t.Errorf("Currency(-5, USD, %q) = %q, want %q", tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
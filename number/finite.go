@@ -0,0 +1,174 @@
+package number
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNotFinite is returned by the strict formatting functions for NaN and
+// infinite numbers.
+var ErrNotFinite = errors.New("number is not finite")
+
+// NonFinite holds the text shown in place of NaN and infinite numbers. Empty
+// fields use the symbols of the locale, such as "NaN", "∞" and "-∞" in
+// English. The text replaces the whole result, without units, currency
+// symbols or percent signs.
+type NonFinite struct {
+	NaN              string
+	PositiveInfinity string
+	NegativeInfinity string
+}
+
+// text returns the text for number and true when number is not finite.
+func (p NonFinite) text(number float64, locale string) (string, bool) {
+	var text string
+	switch {
+	case math.IsNaN(number):
+		text = p.NaN
+	case math.IsInf(number, 1):
+		text = p.PositiveInfinity
+	case math.IsInf(number, -1):
+		text = p.NegativeInfinity
+	default:
+		return "", false
+	}
+	if text == "" {
+		text = printer(locale).Sprint(decimal(number, 0))
+	}
+	return text, true
+}
+
+// checkFinite returns an error wrapping ErrNotFinite when number is NaN or infinite.
+func checkFinite(number float64) error {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return fmt.Errorf("%w: %v", ErrNotFinite, number)
+	}
+	return nil
+}
+
+// FormatStrict formats the given number as Format does, but returns an error
+// instead of a placeholder for NaN and infinite numbers.
+func FormatStrict(number float64, precision *int, maxPrecision *int, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Format(number, precision, maxPrecision, locale), nil
+}
+
+// CurrencyStrict formats the given number as Currency does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func CurrencyStrict(number float64, in string, locale *string, precision *int) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Currency(number, in, locale, precision), nil
+}
+
+// PercentageStrict formats the given number as Percentage does, but returns
+// an error instead of a placeholder for NaN and infinite numbers.
+func PercentageStrict(number float64, precision int, maxPrecision *int, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Percentage(number, precision, maxPrecision, locale), nil
+}
+
+// FileSizeStrict formats the given number as FileSize does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func FileSizeStrict(bytes float64, precision int, maxPrecision *int) (string, error) {
+	if err := checkFinite(bytes); err != nil {
+		return "", err
+	}
+	return FileSize(bytes, precision, maxPrecision), nil
+}
+
+// FileSizeWithStrict formats the given number as FileSizeWith does, but
+// returns an error instead of a placeholder for NaN and infinite numbers.
+func FileSizeWithStrict(bytes float64, standard SizeStandard, precision int, maxPrecision *int, locale *string) (string, error) {
+	if err := checkFinite(bytes); err != nil {
+		return "", err
+	}
+	return FileSizeWith(bytes, standard, precision, maxPrecision, locale), nil
+}
+
+// BitRateStrict formats the given number as BitRate does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func BitRateStrict(bitsPerSecond float64, precision int, maxPrecision *int, locale *string) (string, error) {
+	if err := checkFinite(bitsPerSecond); err != nil {
+		return "", err
+	}
+	return BitRate(bitsPerSecond, precision, maxPrecision, locale), nil
+}
+
+// AbbreviateStrict formats the given number as Abbreviate does, but returns
+// an error instead of a placeholder for NaN and infinite numbers.
+func AbbreviateStrict(number float64, precision int, maxPrecision *int) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Abbreviate(number, precision, maxPrecision), nil
+}
+
+// AbbreviateInStrict formats the given number as AbbreviateIn does, but
+// returns an error instead of a placeholder for NaN and infinite numbers.
+func AbbreviateInStrict(number float64, precision int, maxPrecision *int, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return AbbreviateIn(number, precision, maxPrecision, locale), nil
+}
+
+// ForHumansStrict formats the given number as ForHumans does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func ForHumansStrict(number float64, precision int, maxPrecision *int, abbreviate bool) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return ForHumans(number, precision, maxPrecision, abbreviate), nil
+}
+
+// ForHumansInStrict formats the given number as ForHumansIn does, but returns
+// an error instead of a placeholder for NaN and infinite numbers.
+func ForHumansInStrict(number float64, precision int, maxPrecision *int, abbreviate bool, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return ForHumansIn(number, precision, maxPrecision, abbreviate, locale), nil
+}
+
+// SummarizeStrict formats the given number as Summarize does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func SummarizeStrict(number float64, precision int, maxPrecision *int, units map[int]string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Summarize(number, precision, maxPrecision, units), nil
+}
+
+// SpellStrict spells out the given number as Spell does, but returns an error
+// instead of a placeholder for NaN and infinite numbers.
+func SpellStrict(number float64, locale *string, after *int, until *int) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Spell(number, locale, after, until), nil
+}
+
+// OrdinalStrict formats the given number as Ordinal does, but returns an
+// error instead of a placeholder for NaN and infinite numbers.
+func OrdinalStrict(number float64, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return Ordinal(number, locale), nil
+}
+
+// SpellOrdinalStrict spells out the given number as SpellOrdinal does, but
+// returns an error instead of a placeholder for NaN and infinite numbers.
+func SpellOrdinalStrict(number float64, locale *string) (string, error) {
+	if err := checkFinite(number); err != nil {
+		return "", err
+	}
+	return SpellOrdinal(number, locale), nil
+}
@@ -0,0 +1,126 @@
+package number
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"format NaN", Format(nan, nil, nil, nil), "NaN"},
+		{"format infinity", Format(inf, intPtr(2), nil, nil), "∞"},
+		{"format negative infinity", Format(-inf, nil, nil, nil), "-∞"},
+		{"format locale symbol", Format(nan, nil, nil, stringPtr("fa")), "ناعدد"},
+		{"significant", FormatSignificant(inf, 3, nil), "∞"},
+		{"currency", Currency(inf, "USD", nil, nil), "∞"},
+		{"currency negative", Currency(-inf, "EUR", stringPtr("de"), nil), "-∞"},
+		{"percentage", Percentage(nan, 2, nil, nil), "NaN"},
+		{"file size", FileSize(inf, 2, nil), "∞"},
+		{"file size standard", FileSizeWith(-inf, SizeIEC, 0, nil, nil), "-∞"},
+		{"bit rate", BitRate(nan, 0, nil, nil), "NaN"},
+		{"summarize infinity", Summarize(inf, 0, nil, nil), "∞"},
+		{"summarize negative infinity", Summarize(-inf, 0, nil, nil), "-∞"},
+		{"for humans NaN", ForHumans(nan, 1, nil, false), "NaN"},
+		{"spell", Spell(inf, nil, nil, nil), "∞"},
+		{"ordinal", Ordinal(nan, nil), "NaN"},
+		{"spell ordinal", SpellOrdinal(-inf, nil), "-∞"},
+		{"beyond the largest unit", Summarize(1e18, 0, nil, nil), "1,000Q"},
+		{"ordinal beyond int64", Ordinal(1e20, nil), "100,000,000,000,000,000,000th"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSummarizeLargestFloat(t *testing.T) {
+	result := Summarize(math.MaxFloat64, 0, nil, nil)
+	if !strings.HasPrefix(result, "179,769,313,486,231,") || !strings.HasSuffix(result, "Q") || strings.Count(result, "Q") != 1 {
+		t.Errorf("Summarize(MaxFloat64) = %q, want the number in Q with one suffix", result)
+	}
+}
+
+func TestFormatterNonFinite(t *testing.T) {
+	f := NewFormatter("en", "USD").WithNonFinite(NonFinite{
+		NaN:              "n/a",
+		PositiveInfinity: "unlimited",
+	})
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"format", f.Format(nan), "n/a"},
+		{"currency", f.Currency(inf), "unlimited"},
+		{"percentage", f.Percentage(nan), "n/a"},
+		{"file size", f.FileSize(inf), "unlimited"},
+		{"bit rate", f.BitRate(inf), "unlimited"},
+		{"for humans", f.ForHumans(nan, true), "n/a"},
+		{"summarize", f.Summarize(inf, nil), "unlimited"},
+		{"spell", f.Spell(inf), "unlimited"},
+		{"ordinal", f.Ordinal(nan), "n/a"},
+		{"spell ordinal", f.SpellOrdinal(inf), "unlimited"},
+		{"unset placeholder uses locale", f.Format(-inf), "-∞"},
+		{"finite numbers unaffected", f.Format(1.5), "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStrict(t *testing.T) {
+	funcs := map[string]func(float64) (string, error){
+		"FormatStrict": func(n float64) (string, error) { return FormatStrict(n, nil, nil, nil) },
+		"CurrencyStrict": func(n float64) (string, error) {
+			return CurrencyStrict(n, "USD", nil, nil)
+		},
+		"PercentageStrict": func(n float64) (string, error) { return PercentageStrict(n, 0, nil, nil) },
+		"FileSizeStrict":   func(n float64) (string, error) { return FileSizeStrict(n, 0, nil) },
+		"FileSizeWithStrict": func(n float64) (string, error) {
+			return FileSizeWithStrict(n, SizeIEC, 0, nil, nil)
+		},
+		"BitRateStrict":      func(n float64) (string, error) { return BitRateStrict(n, 0, nil, nil) },
+		"AbbreviateStrict":   func(n float64) (string, error) { return AbbreviateStrict(n, 0, nil) },
+		"ForHumansStrict":    func(n float64) (string, error) { return ForHumansStrict(n, 0, nil, true) },
+		"AbbreviateInStrict": func(n float64) (string, error) { return AbbreviateInStrict(n, 0, nil, stringPtr("id")) },
+		"ForHumansInStrict": func(n float64) (string, error) {
+			return ForHumansInStrict(n, 0, nil, false, stringPtr("id"))
+		},
+		"SummarizeStrict":    func(n float64) (string, error) { return SummarizeStrict(n, 0, nil, nil) },
+		"SpellStrict":        func(n float64) (string, error) { return SpellStrict(n, nil, nil, nil) },
+		"OrdinalStrict":      func(n float64) (string, error) { return OrdinalStrict(n, nil) },
+		"SpellOrdinalStrict": func(n float64) (string, error) { return SpellOrdinalStrict(n, nil) },
+	}
+
+	for name, fn := range funcs {
+		t.Run(name, func(t *testing.T) {
+			for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+				if result, err := fn(value); !errors.Is(err, ErrNotFinite) || result != "" {
+					t.Errorf("%s(%v) = %q, %v, want %v", name, value, result, err, ErrNotFinite)
+				}
+			}
+			if result, err := fn(1); err != nil || result == "" {
+				t.Errorf("%s(1) = %q, %v", name, result, err)
+			}
+		})
+	}
+}
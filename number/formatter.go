@@ -0,0 +1,246 @@
+package number
+
+import "context"
+
+// Formatter formats and parses numbers for one locale, currency, precision and
+// rounding mode without touching the package defaults. A Formatter is
+// immutable, so it is safe to share between goroutines; the With methods
+// return a modified copy.
+type Formatter struct {
+	locale       string
+	currency     string
+	precision    *int
+	maxPrecision *int
+	significant  int
+	rounding     RoundingMode
+	size         SizeStandard
+	nonFinite    NonFinite
+}
+
+// formatterKey is the context key for a Formatter.
+type formatterKey struct{}
+
+// NewFormatter returns a Formatter for the given locale and currency. An empty
+// locale or currency uses the default at the time of the call.
+func NewFormatter(locale, currency string) Formatter {
+	if locale == "" {
+		locale = DefaultLocale()
+	}
+	if currency == "" {
+		currency = DefaultCurrency()
+	}
+	return Formatter{locale: locale, currency: currency}
+}
+
+// NewContext returns a copy of ctx that carries the given Formatter.
+func NewContext(ctx context.Context, f Formatter) context.Context {
+	return context.WithValue(ctx, formatterKey{}, f)
+}
+
+// FromContext returns the Formatter carried by ctx, or a Formatter for the
+// default locale and currency when there is none.
+func FromContext(ctx context.Context) Formatter {
+	if f, ok := ctx.Value(formatterKey{}).(Formatter); ok {
+		return f
+	}
+	return NewFormatter("", "")
+}
+
+// WithLocale returns a copy of the Formatter using the given locale.
+func (f Formatter) WithLocale(locale string) Formatter {
+	f.locale = locale
+	return f
+}
+
+// WithCurrency returns a copy of the Formatter using the given currency.
+func (f Formatter) WithCurrency(currency string) Formatter {
+	f.currency = currency
+	return f
+}
+
+// WithPrecision returns a copy of the Formatter using the given precision.
+func (f Formatter) WithPrecision(precision int) Formatter {
+	f.precision = &precision
+	return f
+}
+
+// WithMaxPrecision returns a copy of the Formatter using the given maximum
+// precision. Together with WithPrecision it sets the minimum and maximum
+// number of fraction digits, as in Format.
+func (f Formatter) WithMaxPrecision(maxPrecision int) Formatter {
+	f.maxPrecision = &maxPrecision
+	return f
+}
+
+// WithSignificantDigits returns a copy of the Formatter that formats numbers
+// with at most the given number of significant digits instead of a number of
+// fraction digits, as in FormatSignificant. It does not apply to Currency.
+func (f Formatter) WithSignificantDigits(significant int) Formatter {
+	f.significant = significant
+	return f
+}
+
+// WithRoundingMode returns a copy of the Formatter using the given rounding mode.
+func (f Formatter) WithRoundingMode(mode RoundingMode) Formatter {
+	f.rounding = mode
+	return f
+}
+
+// WithSizeStandard returns a copy of the Formatter using the units of the
+// given standard for file sizes.
+func (f Formatter) WithSizeStandard(standard SizeStandard) Formatter {
+	f.size = standard
+	return f
+}
+
+// WithNonFinite returns a copy of the Formatter that shows the given
+// placeholders in place of NaN and infinite numbers.
+func (f Formatter) WithNonFinite(placeholders NonFinite) Formatter {
+	f.nonFinite = placeholders
+	return f
+}
+
+// Locale returns the locale of the Formatter.
+func (f Formatter) Locale() string {
+	return f.locale
+}
+
+// CurrencyCode returns the currency of the Formatter.
+func (f Formatter) CurrencyCode() string {
+	return f.currency
+}
+
+// RoundingMode returns the rounding mode of the Formatter.
+func (f Formatter) RoundingMode() RoundingMode {
+	return f.rounding
+}
+
+// digits returns the digits the Formatter shows, or fallback when it has no
+// precision of its own.
+func (f Formatter) digits(fallback digits) digits {
+	switch {
+	case f.significant > 0:
+		return digits{significant: f.significant}
+	case f.precision == nil && f.maxPrecision == nil:
+		return fallback
+	default:
+		return fractionRange(f.precision, f.maxPrecision)
+	}
+}
+
+// Format formats the given number, as Format does.
+func (f Formatter) Format(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return formatDecimal(number, f.digits(digits{max: -1}), f.rounding, f.locale)
+}
+
+// Currency formats the given number in the Formatter's currency, as Currency does.
+func (f Formatter) Currency(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return formatAmount(number, f.currency, f.precision, f.maxPrecision, f.rounding, f.locale)
+}
+
+// Money formats the given amount of money, as Money.Format does.
+func (f Formatter) Money(m Money) string {
+	return m.Format(&f.locale)
+}
+
+// Percentage formats the given number as a percentage, as Percentage does.
+func (f Formatter) Percentage(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return percentage(number, f.digits(exactly(0)), f.rounding, f.locale)
+}
+
+// FileSize formats the given number of bytes in the units of the Formatter's
+// size standard, as FileSizeWith does.
+func (f Formatter) FileSize(bytes float64) string {
+	if text, ok := f.nonFinite.text(bytes, f.locale); ok {
+		return text
+	}
+	return fileSize(bytes, f.size, f.digits(exactly(0)), f.rounding, f.locale)
+}
+
+// BitRate formats the given number of bits per second, as BitRate does.
+func (f Formatter) BitRate(bitsPerSecond float64) string {
+	if text, ok := f.nonFinite.text(bitsPerSecond, f.locale); ok {
+		return text
+	}
+	return bitRate(bitsPerSecond, f.digits(exactly(0)), f.rounding, f.locale)
+}
+
+// ForHumans formats the given number for humans in the units of the
+// Formatter's locale, as ForHumans does.
+func (f Formatter) ForHumans(number float64, abbreviate bool) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return summarize(number, f.digits(exactly(0)), humanUnitsOf(f.locale, abbreviate), f.rounding, f.locale)
+}
+
+// Abbreviate formats the given number in abbreviated form, as Abbreviate does.
+func (f Formatter) Abbreviate(number float64) string {
+	return f.ForHumans(number, true)
+}
+
+// Summarize formats the given number with custom units, as Summarize does.
+func (f Formatter) Summarize(number float64, units map[int]string) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	if len(units) == 0 {
+		units = defaultUnits
+	}
+	return summarize(number, f.digits(exactly(0)), unitsFromMap(units), f.rounding, f.locale)
+}
+
+// Spell spells out the given number, as Spell does.
+func (f Formatter) Spell(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return Spell(number, &f.locale, nil, nil)
+}
+
+// Ordinal formats the given number as an ordinal, as Ordinal does.
+func (f Formatter) Ordinal(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return Ordinal(number, &f.locale)
+}
+
+// SpellOrdinal spells out the given number as an ordinal, as SpellOrdinal does.
+func (f Formatter) SpellOrdinal(number float64) string {
+	if text, ok := f.nonFinite.text(number, f.locale); ok {
+		return text
+	}
+	return SpellOrdinal(number, &f.locale)
+}
+
+// Parse parses a number, as Parse does.
+func (f Formatter) Parse(value string) (float64, error) {
+	return Parse(value, &f.locale)
+}
+
+// ParseCurrency parses an amount of money, as ParseCurrency does. Amounts
+// without a symbol are in the Formatter's currency.
+func (f Formatter) ParseCurrency(value string) (float64, string, error) {
+	return parseCurrency(value, f.locale, f.currency)
+}
+
+// ParseFileSize parses a file size in the units of the Formatter's size
+// standard, as ParseFileSizeWith does.
+func (f Formatter) ParseFileSize(value string) (float64, error) {
+	return parseFileSize(value, f.size, f.locale)
+}
+
+// ParsePercentage parses a percentage, as ParsePercentage does.
+func (f Formatter) ParsePercentage(value string) (float64, error) {
+	return ParsePercentage(value, &f.locale)
+}
@@ -0,0 +1,193 @@
+package number
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestNewFormatter(t *testing.T) {
+	f := NewFormatter("", "")
+	if f.Locale() != DefaultLocale() || f.CurrencyCode() != DefaultCurrency() {
+		t.Errorf("NewFormatter() = %s %s, want defaults", f.Locale(), f.CurrencyCode())
+	}
+
+	f = NewFormatter("de", "EUR")
+	if f.Locale() != "de" || f.CurrencyCode() != "EUR" {
+		t.Errorf("NewFormatter() = %s %s, want de EUR", f.Locale(), f.CurrencyCode())
+	}
+}
+
+func TestFormatterWith(t *testing.T) {
+	base := NewFormatter("en", "USD")
+	de := base.WithLocale("de").WithCurrency("EUR").WithPrecision(2)
+
+	if base.Locale() != "en" || base.CurrencyCode() != "USD" {
+		t.Errorf("With methods modified the original Formatter: %s %s", base.Locale(), base.CurrencyCode())
+	}
+	if result := base.Format(1234.5); result != "1,234.5" {
+		t.Errorf("Format() = %q, want %q", result, "1,234.5")
+	}
+	if result := de.Format(1234.5); result != "1.234,50" {
+		t.Errorf("Format() = %q, want %q", result, "1.234,50")
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	id := NewFormatter("id", "IDR")
+	de := NewFormatter("de", "EUR")
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"currency", id.Currency(1500000), "Rp\u00a01.500.000"},
+		{"currency after amount", de.Currency(1234.5), "1.234,50\u00a0€"},
+		{"money", de.Money(NewMoney(1050, "EUR")), "10,50\u00a0€"},
+		{"percentage", de.WithPrecision(1).Percentage(1250), "12,5\u00a0%"},
+		{"file size", de.WithPrecision(1).FileSize(1536), "1,5 KB"},
+		{"abbreviate", de.WithPrecision(1).Abbreviate(1500), "1,5\u00a0Tsd."},
+		{"for humans", id.ForHumans(2000000, false), "2 juta"},
+		{"summarize", id.Summarize(3000, map[int]string{3: "rb"}), "3rb"},
+		{"spell", id.Spell(21), "dua puluh satu"},
+		{"ordinal", de.Ordinal(3), "3."},
+		{"spell ordinal", id.SpellOrdinal(2), "kedua"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatterRoundingMode(t *testing.T) {
+	f := NewFormatter("en", "USD").WithPrecision(2)
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"half even format", f.Format(0.125), "0.12"},
+		{"half up format", f.WithRoundingMode(RoundHalfUp).Format(0.125), "0.13"},
+		{"half up currency", f.WithRoundingMode(RoundHalfUp).Currency(2.675), "$2.68"},
+		{"floor negative currency", f.WithRoundingMode(RoundFloor).Currency(-1.001), "-$1.01"},
+		{"down currency to zero", f.WithRoundingMode(RoundDown).Currency(-0.009), "$0.00"},
+		{"ceiling percentage", f.WithRoundingMode(RoundCeiling).Percentage(1234.1), "12.35%"},
+		{"floor abbreviate stays below unit", f.WithPrecision(0).WithRoundingMode(RoundFloor).Abbreviate(1999), "1K"},
+		{"floor does not roll over", f.WithRoundingMode(RoundFloor).Abbreviate(999999), "999.99K"},
+		{"floor negative abbreviate", f.WithPrecision(1).WithRoundingMode(RoundFloor).Abbreviate(-1510), "-1.6K"},
+		{"floor file size", f.WithPrecision(1).WithRoundingMode(RoundFloor).FileSize(2047), "1.9 KB"},
+		{"ceiling file size", f.WithPrecision(0).WithRoundingMode(RoundCeiling).FileSize(1025), "2 KB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+
+	if mode := f.WithRoundingMode(RoundFloor).RoundingMode(); mode != RoundFloor {
+		t.Errorf("RoundingMode() = %s, want %s", mode, RoundFloor)
+	}
+}
+
+func TestFormatterDigits(t *testing.T) {
+	f := NewFormatter("en", "USD")
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"max precision", f.WithMaxPrecision(2).Format(1.5), "1.5"},
+		{"min and max precision", f.WithPrecision(1).WithMaxPrecision(3).Format(2), "2.0"},
+		{"currency max precision", f.WithMaxPrecision(2).Currency(3), "$3"},
+		{"currency default precision", f.Currency(3), "$3.00"},
+		{"significant format", f.WithSignificantDigits(3).Format(1234.5), "1,230"},
+		{"significant abbreviate", f.WithSignificantDigits(3).Abbreviate(1234567), "1.23M"},
+		{"significant file size", f.WithSignificantDigits(2).FileSize(1536), "1.5 KB"},
+		{"significant percentage", f.WithSignificantDigits(2).Percentage(1234.5), "12%"},
+		{"size standard", f.WithPrecision(1).WithSizeStandard(SizeIEC).FileSize(1536), "1.5 KiB"},
+		{"bit rate", f.WithMaxPrecision(1).BitRate(1.5e6), "1.5 Mbps"},
+		{"significant ignored by currency", f.WithSignificantDigits(1).Currency(12.34), "$12.34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatterParse(t *testing.T) {
+	f := NewFormatter("de", "EUR")
+
+	if result, err := f.Parse("1.234,5"); err != nil || result != 1234.5 {
+		t.Errorf("Parse() = %v, %v, want 1234.5", result, err)
+	}
+	if result, err := f.WithSizeStandard(SizeSI).ParseFileSize("1,5 kB"); err != nil || result != 1500 {
+		t.Errorf("ParseFileSize() = %v, %v, want 1500", result, err)
+	}
+	if result, err := f.ParsePercentage("12,5 %"); err != nil || result != 1250 {
+		t.Errorf("ParsePercentage() = %v, %v, want 1250", result, err)
+	}
+
+	// Amounts without a symbol use the Formatter's currency, not the default
+	amount, code, err := f.ParseCurrency("10,50")
+	if err != nil || amount != 10.5 || code != "EUR" {
+		t.Errorf("ParseCurrency() = %v %s, %v, want 10.5 EUR", amount, code, err)
+	}
+}
+
+func TestContext(t *testing.T) {
+	if f := FromContext(context.Background()); f.Locale() != DefaultLocale() {
+		t.Errorf("FromContext() locale = %s, want %s", f.Locale(), DefaultLocale())
+	}
+
+	ctx := NewContext(context.Background(), NewFormatter("id", "IDR"))
+	if f := FromContext(ctx); f.Locale() != "id" || f.CurrencyCode() != "IDR" {
+		t.Errorf("FromContext() = %s %s, want id IDR", f.Locale(), f.CurrencyCode())
+	}
+}
+
+func TestFormatterConcurrent(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"en", "1,234.5"},
+		{"de", "1.234,5"},
+		{"fr", "1\u00a0234,5"},
+	}
+
+	var wg sync.WaitGroup
+	for _, tt := range tests {
+		ctx := NewContext(context.Background(), NewFormatter(tt.locale, ""))
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if result := FromContext(ctx).Format(1234.5); result != tt.expected {
+					t.Errorf("Format() in %s = %q, want %q", tt.locale, result, tt.expected)
+				}
+			}()
+		}
+	}
+
+	// Changing the default while formatters are in use does not affect them
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		WithLocale("id", func() {})
+	}()
+	wg.Wait()
+}
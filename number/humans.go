@@ -0,0 +1,166 @@
+package number
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rulzi/helper-go/internal/langtag"
+	"golang.org/x/text/feature/plural"
+)
+
+// HumanUnit is a unit used by ForHumans and Abbreviate, such as a thousand.
+type HumanUnit struct {
+	// Exponent is the power of ten of the unit, such as 3 for a thousand
+	Exponent int
+	// One is the text appended to the number, including any leading space
+	One string
+	// Other is the plural text for languages whose units inflect, such as
+	// " millions" in French; empty means One is always used
+	Other string
+}
+
+// HumanUnits holds the units ForHumans uses for a locale, ordered from the
+// smallest exponent to the largest. Exponents need not be evenly spaced, so
+// Japanese can group by 10^4 and Indian English can use lakh and crore.
+type HumanUnits struct {
+	Full        []HumanUnit
+	Abbreviated []HumanUnit
+}
+
+var (
+	// Mutex for thread-safe access to the human unit registry
+	humanUnitsMu sync.RWMutex
+
+	// Human units by language tag, such as "en" or "en-IN", looked up with
+	// langtag.Lookup
+	humanUnits = map[string]HumanUnits{
+		"en": {
+			Full:        unitsFromMap(fullUnits),
+			Abbreviated: unitsFromMap(abbreviatedUnits),
+		},
+		"en-IN": {
+			Full:        []HumanUnit{{3, " thousand", ""}, {5, " lakh", ""}, {7, " crore", ""}, {12, " lakh crore", ""}},
+			Abbreviated: []HumanUnit{{3, "K", ""}, {5, "L", ""}, {7, "Cr", ""}, {12, "LCr", ""}},
+		},
+		"id": {
+			Full: []HumanUnit{
+				{3, " ribu", ""}, {6, " juta", ""}, {9, " miliar", ""}, {12, " triliun", ""},
+				{15, " kuadriliun", ""}, {18, " kuintiliun", ""},
+			},
+			// Units above triliun have no common abbreviation
+			Abbreviated: []HumanUnit{
+				{3, " rb", ""}, {6, " jt", ""}, {9, " M", ""}, {12, " T", ""},
+				{15, " kuadriliun", ""}, {18, " kuintiliun", ""},
+			},
+		},
+		"ja": {
+			Full:        []HumanUnit{{4, "万", ""}, {8, "億", ""}, {12, "兆", ""}, {16, "京", ""}},
+			Abbreviated: []HumanUnit{{4, "万", ""}, {8, "億", ""}, {12, "兆", ""}, {16, "京", ""}},
+		},
+		"zh": {
+			Full:        []HumanUnit{{4, "万", ""}, {8, "亿", ""}, {12, "万亿", ""}},
+			Abbreviated: []HumanUnit{{4, "万", ""}, {8, "亿", ""}, {12, "万亿", ""}},
+		},
+		"zh-Hant": {
+			Full:        []HumanUnit{{4, "萬", ""}, {8, "億", ""}, {12, "兆", ""}},
+			Abbreviated: []HumanUnit{{4, "萬", ""}, {8, "億", ""}, {12, "兆", ""}},
+		},
+		"fr": {
+			Full: []HumanUnit{
+				{3, " mille", ""}, {6, " million", " millions"},
+				{9, " milliard", " milliards"}, {12, " billion", " billions"},
+			},
+			Abbreviated: []HumanUnit{{3, "\u00a0k", ""}, {6, "\u00a0M", ""}, {9, "\u00a0Md", ""}, {12, "\u00a0Bn", ""}},
+		},
+		"de": {
+			Full: []HumanUnit{
+				{3, " Tausend", ""}, {6, " Million", " Millionen"},
+				{9, " Milliarde", " Milliarden"}, {12, " Billion", " Billionen"},
+			},
+			Abbreviated: []HumanUnit{{3, "\u00a0Tsd.", ""}, {6, "\u00a0Mio.", ""}, {9, "\u00a0Mrd.", ""}, {12, "\u00a0Bio.", ""}},
+		},
+		"es": {
+			Full: []HumanUnit{
+				{3, " mil", ""}, {6, " millón", " millones"},
+				{9, " mil millones", ""}, {12, " billón", " billones"},
+			},
+			Abbreviated: []HumanUnit{{3, "\u00a0mil", ""}, {6, "\u00a0M", ""}, {9, "\u00a0mil\u00a0M", ""}, {12, "\u00a0B", ""}},
+		},
+	}
+)
+
+// RegisterHumanUnits registers the units ForHumans and Abbreviate use for the
+// given locale. Locales without units of their own use those of their parent
+// locale, so units for "pt" also apply to "pt-BR".
+func RegisterHumanUnits(locale string, units HumanUnits) {
+	units.Full = sortedUnits(units.Full)
+	units.Abbreviated = sortedUnits(units.Abbreviated)
+
+	humanUnitsMu.Lock()
+	defer humanUnitsMu.Unlock()
+	humanUnits[localeTag(locale).String()] = units
+}
+
+// humanUnitsFor returns the units for the locale, falling back to English.
+func humanUnitsFor(locale string) HumanUnits {
+	humanUnitsMu.RLock()
+	defer humanUnitsMu.RUnlock()
+
+	if units, ok := langtag.Lookup(humanUnits, localeTag(locale)); ok {
+		return units
+	}
+	return humanUnits["en"]
+}
+
+// humanUnitsOf returns the full or abbreviated units for the locale.
+func humanUnitsOf(locale string, abbreviate bool) []HumanUnit {
+	units := humanUnitsFor(locale)
+	if abbreviate {
+		return units.Abbreviated
+	}
+	return units.Full
+}
+
+// unitsFromMap converts units keyed by exponent, as taken by Summarize, into
+// a sorted list, dropping empty units.
+func unitsFromMap(units map[int]string) []HumanUnit {
+	var list []HumanUnit
+	for exp, unit := range units {
+		if unit != "" {
+			list = append(list, HumanUnit{Exponent: exp, One: unit})
+		}
+	}
+	return sortedUnits(list)
+}
+
+// sortedUnits returns a copy of units ordered by exponent.
+func sortedUnits(units []HumanUnit) []HumanUnit {
+	units = slices.Clone(units)
+	slices.SortFunc(units, func(a, b HumanUnit) int {
+		return a.Exponent - b.Exponent
+	})
+	return units
+}
+
+// text returns the unit text for a number of the given plural form.
+func (u HumanUnit) text(form plural.Form) string {
+	if u.Other != "" && form != plural.One {
+		return u.Other
+	}
+	return u.One
+}
+
+// pluralForm returns the CLDR cardinal plural form of value shown with the
+// given number of fraction digits in the locale.
+func pluralForm(locale string, value float64, fraction int) plural.Form {
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', fraction, 64)
+	integer, visible, _ := strings.Cut(formatted, ".")
+	trimmed := strings.TrimRight(visible, "0")
+	return plural.Cardinal.MatchPlural(localeTag(locale),
+		lastDigits(integer, 7), len(visible), len(trimmed),
+		lastDigits(visible, 7), lastDigits(trimmed, 7),
+	)
+}
@@ -0,0 +1,105 @@
+package number
+
+import "testing"
+
+func TestForHumansLocales(t *testing.T) {
+	tests := []struct {
+		name       string
+		locale     string
+		number     float64
+		abbreviate bool
+		expected   string
+	}{
+		{"indonesian thousand", "id", 1500, false, "1,5 ribu"},
+		{"indonesian million", "id", 2000000, false, "2 juta"},
+		{"indonesian billion", "id", 3e9, false, "3 miliar"},
+		{"indonesian trillion", "id", 4e12, false, "4 triliun"},
+		{"indonesian quadrillion", "id", 1.5e15, false, "1,5 kuadriliun"},
+		{"indonesian quintillion", "id", 2e18, true, "2 kuintiliun"},
+		{"beyond the largest indonesian unit", "id", 1e21, false, "1.000 kuintiliun"},
+		{"indonesian abbreviated", "id_ID", 2500000, true, "2,5 jt"},
+		{"indonesian abbreviated billion", "id", 7e9, true, "7 M"},
+		{"japanese man", "ja", 15000, false, "1.5万"},
+		{"japanese oku", "ja", 230000000, true, "2.3億"},
+		{"japanese below man", "ja", 9999, false, "9,999"},
+		{"chinese yi", "zh", 120000000, false, "1.2亿"},
+		{"traditional chinese", "zh-TW", 50000, false, "5萬"},
+		{"hong kong uses traditional", "zh-HK", 50000, false, "5萬"},
+		{"traditional script", "zh-Hant", 120000000, false, "1.2億"},
+		{"indian lakh", "en-IN", 250000, false, "2.5 lakh"},
+		{"indian crore", "en-IN", 32000000, true, "3.2Cr"},
+		{"indian lakh crore", "en-IN", 1e12, false, "1 lakh crore"},
+		{"indian thousand crore", "en-IN", 1e10, false, "1,000 crore"},
+		{"indian lakh crore abbreviated", "en-IN", 2.5e12, true, "2.5LCr"},
+		{"beyond the largest unit", "en", 1e18, false, "1,000 quadrillion"},
+		{"french singular", "fr", 1500000, false, "1,5 million"},
+		{"french plural", "fr", 2000000, false, "2 millions"},
+		{"german plural", "de", 1500000, false, "1,5 Millionen"},
+		{"german singular", "de", 1000000, false, "1 Million"},
+		{"spanish plural", "es", 3000000, false, "3 millones"},
+		{"spanish abbreviated", "es", 3000000, true, "3\u00a0M"},
+		{"unknown locale uses english", "sw", 1500, true, "1.5K"},
+		{"negative", "id", -2000000, false, "-2 juta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewFormatter(tt.locale, "").WithMaxPrecision(1).ForHumans(tt.number, tt.abbreviate)
+			if result != tt.expected {
+				t.Errorf("ForHumans(%v) in %s = %q, want %q", tt.number, tt.locale, result, tt.expected)
+			}
+		})
+	}
+
+	WithLocale("id", func() {
+		if result := Abbreviate(1500000, 1, nil); result != "1,5 jt" {
+			t.Errorf("Abbreviate(1500000) = %q, want %q", result, "1,5 jt")
+		}
+	})
+
+	if result := ForHumansIn(2e15, 0, nil, false, stringPtr("id")); result != "2 kuadriliun" {
+		t.Errorf("ForHumansIn(2e15) = %q, want %q", result, "2 kuadriliun")
+	}
+	if result := AbbreviateIn(1500, 1, nil, stringPtr("de")); result != "1,5\u00a0Tsd." {
+		t.Errorf("AbbreviateIn(1500) = %q, want %q", result, "1,5\u00a0Tsd.")
+	}
+	if result := ForHumansIn(1500, 1, nil, false, nil); result != "1.5 thousand" {
+		t.Errorf("ForHumansIn(1500) in the default locale = %q, want %q", result, "1.5 thousand")
+	}
+}
+
+func TestRegisterHumanUnits(t *testing.T) {
+	defer func() {
+		humanUnitsMu.Lock()
+		delete(humanUnits, "nl")
+		humanUnitsMu.Unlock()
+	}()
+
+	RegisterHumanUnits("nl", HumanUnits{
+		Full:        []HumanUnit{{Exponent: 6, One: " miljoen"}, {Exponent: 3, One: " duizend"}},
+		Abbreviated: []HumanUnit{{Exponent: 3, One: "K"}, {Exponent: 6, One: " mln."}},
+	})
+
+	tests := []struct {
+		name       string
+		locale     string
+		abbreviate bool
+		expected   string
+	}{
+		{"registered language", "nl", false, "2,5 miljoen"},
+		{"regional variant", "nl-BE", true, "2,5 mln."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewFormatter(tt.locale, "").WithMaxPrecision(1).ForHumans(2500000, tt.abbreviate)
+			if result != tt.expected {
+				t.Errorf("ForHumans(2500000) in %s = %q, want %q", tt.locale, result, tt.expected)
+			}
+		})
+	}
+
+	if result, err := ParseForHumans("2,5 miljoen", stringPtr("nl")); err != nil || result != 2500000 {
+		t.Errorf("ParseForHumans() = %v, %v, want 2500000", result, err)
+	}
+}
@@ -0,0 +1,246 @@
+package number
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	xnumber "golang.org/x/text/number"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrMoneyOverflow is returned when a result does not fit in the minor-unit amount.
+	ErrMoneyOverflow = errors.New("money overflow")
+)
+
+// Money is an exact amount of money, stored as an integer number of minor
+// units (such as cents) together with an ISO 4217 currency code.
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// NewMoney creates an amount of money from a number of minor units, so
+// NewMoney(1050, "USD") is $10.50 and NewMoney(1050, "JPY") is ¥1,050.
+func NewMoney(minor int64, currency string) Money {
+	return Money{amount: minor, currency: strings.ToUpper(currency)}
+}
+
+// MoneyFromString creates an amount of money from a plain decimal string such
+// as "10.50" without going through float64. It returns an error when the
+// string has more fraction digits than the currency's minor unit.
+func MoneyFromString(amount string, currency string) (Money, error) {
+	currency = strings.ToUpper(currency)
+	scale := MinorUnits(currency)
+
+	s := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	if (integer == "" && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("%w %q", ErrInvalidNumber, amount)
+	}
+	if len(fraction) > scale {
+		if strings.TrimRight(fraction[scale:], "0") != "" {
+			return Money{}, fmt.Errorf("%w %q has more than %d fraction digits for %s", ErrInvalidNumber, amount, scale, currency)
+		}
+		fraction = fraction[:scale]
+	}
+
+	minor, ok := new(big.Int).SetString(integer+fraction+strings.Repeat("0", scale-len(fraction)), 10)
+	if !ok {
+		return Money{}, fmt.Errorf("%w %q", ErrInvalidNumber, amount)
+	}
+	if negative {
+		minor.Neg(minor)
+	}
+	if !minor.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %q", ErrMoneyOverflow, amount)
+	}
+	return Money{amount: minor.Int64(), currency: currency}, nil
+}
+
+// MoneyFromFloat creates an amount of money from a float64, taken as its
+// shortest decimal representation and rounded to the nearest minor unit with
+// ties to even, so 1.015 dollars are $1.02.
+func MoneyFromFloat(amount float64, currency string) (Money, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return Money{}, fmt.Errorf("%w %v", ErrInvalidNumber, amount)
+	}
+
+	minor := decimalRat(amount)
+	minor.Mul(minor, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(MinorUnits(currency))), nil)))
+
+	rounded := RoundHalfEven.roundRat(minor)
+	if !rounded.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %v", ErrMoneyOverflow, amount)
+	}
+	return Money{amount: rounded.Int64(), currency: currency}, nil
+}
+
+// Amount returns the amount in minor units.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency returns the ISO 4217 currency code.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsNegative reports whether the amount is below zero.
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Float64 returns the amount in major units. The result may not be exact.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.decimal(), 64)
+	return f
+}
+
+// Add returns the sum of both amounts, which must be in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: cannot add %s to %s", ErrCurrencyMismatch, other.currency, m.currency)
+	}
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return Money{}, fmt.Errorf("%w: %s + %s", ErrMoneyOverflow, m, other)
+	}
+	return Money{amount: sum, currency: m.currency}, nil
+}
+
+// Sub returns the difference of both amounts, which must be in the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: cannot subtract %s from %s", ErrCurrencyMismatch, other.currency, m.currency)
+	}
+	diff := m.amount - other.amount
+	if (other.amount < 0 && diff < m.amount) || (other.amount > 0 && diff > m.amount) {
+		return Money{}, fmt.Errorf("%w: %s - %s", ErrMoneyOverflow, m, other)
+	}
+	return Money{amount: diff, currency: m.currency}, nil
+}
+
+// Multiply multiplies the amount by factor, taken as its shortest decimal
+// representation, and rounds the result to the nearest minor unit with ties
+// to even, so $10.00 times 1.015 is $10.15.
+func (m Money) Multiply(factor float64) (Money, error) {
+	if math.IsNaN(factor) || math.IsInf(factor, 0) {
+		return Money{}, fmt.Errorf("%w factor %v", ErrInvalidNumber, factor)
+	}
+
+	product := decimalRat(factor)
+	product.Mul(product, new(big.Rat).SetInt64(m.amount))
+
+	rounded := RoundHalfEven.roundRat(product)
+	if !rounded.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %s * %v", ErrMoneyOverflow, m, factor)
+	}
+	return Money{amount: rounded.Int64(), currency: m.currency}, nil
+}
+
+// Allocate splits the amount by the given ratios without losing minor units.
+// Each share is rounded toward zero and the remaining minor units go one at a
+// time to the first shares, so Allocate(1, 1, 1) of $100.00 gives $33.34,
+// $33.33 and $33.33.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("cannot allocate %s without ratios", m)
+	}
+
+	total := int64(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("cannot allocate %s by negative ratio %d", m, ratio)
+		}
+		total += int64(ratio)
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("cannot allocate %s by ratios summing to zero", m)
+	}
+
+	amount := big.NewInt(m.amount)
+	remainder := m.amount
+	shares := make([]Money, len(ratios))
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(int64(ratio)))
+		share.Quo(share, big.NewInt(total))
+		shares[i] = Money{amount: share.Int64(), currency: m.currency}
+		remainder -= share.Int64()
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0; i++ {
+		if ratios[i%len(ratios)] == 0 {
+			continue
+		}
+		shares[i%len(ratios)].amount += step
+		remainder -= step
+	}
+	return shares, nil
+}
+
+// Format formats the amount with its currency using the same rules as
+// Currency, without going through float64.
+func (m Money) Format(locale *string) string {
+	loc := localeOrDefault(locale)
+
+	abs := uint64(m.amount)
+	if m.amount < 0 {
+		abs = -abs
+	}
+
+	scale := MinorUnits(m.currency)
+	pow := uint64(math.Pow10(scale))
+	// The whole units are exact as an integer, with all of their digits
+	whole := abs / pow
+	formatted := printer(loc).Sprint(xnumber.Decimal(whole, xnumber.Precision(len(strconv.FormatUint(whole, 10)))))
+
+	if scale > 0 {
+		sym := symbolsFor(loc)
+		fraction := fmt.Sprintf("%0*d", scale, abs%pow)
+		formatted += sym.decimal + strings.Map(sym.nativeDigit, fraction)
+	}
+
+	return formatCurrency(formatted, m.amount < 0, m.currency, loc)
+}
+
+// String returns the amount as a plain decimal followed by the currency code,
+// such as "10.50 USD".
+func (m Money) String() string {
+	return m.decimal() + " " + m.currency
+}
+
+// decimal returns the amount as a plain decimal string such as "-10.50".
+func (m Money) decimal() string {
+	scale := MinorUnits(m.currency)
+	digits := strconv.FormatInt(m.amount, 10)
+
+	sign := ""
+	if m.amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
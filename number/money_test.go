@@ -0,0 +1,259 @@
+package number
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNewMoney(t *testing.T) {
+	m := NewMoney(1050, "usd")
+	if m.Amount() != 1050 || m.Currency() != "USD" {
+		t.Errorf("NewMoney(1050, %q) = %d %s, want 1050 USD", "usd", m.Amount(), m.Currency())
+	}
+}
+
+func TestMoneyFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		expected int64
+		err      error
+	}{
+		{"cents", "10.50", "USD", 1050, nil},
+		{"missing fraction digits", "10.5", "USD", 1050, nil},
+		{"whole", "7", "USD", 700, nil},
+		{"negative", "-0.01", "USD", -1, nil},
+		{"zero decimals", "1050", "JPY", 1050, nil},
+		{"three decimals", "1.234", "BHD", 1234, nil},
+		{"trailing zeros beyond scale", "10.500", "USD", 1050, nil},
+		{"large rupiah total", "9007199254740993", "IDR", 9007199254740993, nil},
+		{"too many fraction digits", "10.505", "USD", 0, ErrInvalidNumber},
+		{"fraction for zero decimals", "1.5", "JPY", 0, ErrInvalidNumber},
+		{"not a number", "ten", "USD", 0, ErrInvalidNumber},
+		{"overflow", "99999999999999999999", "USD", 0, ErrMoneyOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MoneyFromString(tt.amount, tt.currency)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("MoneyFromString(%q) error = %v, want %v", tt.amount, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MoneyFromString(%q) error = %v", tt.amount, err)
+			}
+			if result.Amount() != tt.expected {
+				t.Errorf("MoneyFromString(%q) = %d, want %d", tt.amount, result.Amount(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		expected int64
+	}{
+		{"sum of floats", 0.1 + 0.2, "USD", 30},
+		{"rounds to minor unit", 10.456, "USD", 1046},
+		{"zero decimals", 1050.4, "JPY", 1050},
+		{"negative", -2.5, "EUR", -250},
+		{"shortest decimal tie", 1.015, "USD", 102},
+		{"shortest decimal tie to even", 1.025, "USD", 102},
+		{"negative shortest decimal tie", -2.675, "USD", -268},
+		{"three decimals", 1.0005, "BHD", 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MoneyFromFloat(tt.amount, tt.currency)
+			if err != nil {
+				t.Fatalf("MoneyFromFloat(%v) error = %v", tt.amount, err)
+			}
+			if result.Amount() != tt.expected {
+				t.Errorf("MoneyFromFloat(%v) = %d, want %d", tt.amount, result.Amount(), tt.expected)
+			}
+		})
+	}
+
+	if _, err := MoneyFromFloat(math.NaN(), "USD"); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("MoneyFromFloat(NaN) error = %v, want %v", err, ErrInvalidNumber)
+	}
+	if _, err := MoneyFromFloat(1e20, "USD"); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("MoneyFromFloat(1e20) error = %v, want %v", err, ErrMoneyOverflow)
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected int
+	}{
+		{"USD", 2},
+		{"JPY", 0},
+		{"IDR", 0},
+		{"BHD", 3},
+		{"unknown", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if result := MinorUnits(tt.code); result != tt.expected {
+				t.Errorf("MinorUnits(%q) = %d, want %d", tt.code, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	sum, err := NewMoney(10, "USD").Add(NewMoney(20, "USD"))
+	if err != nil || sum != NewMoney(30, "USD") {
+		t.Errorf("Add() = %v, %v, want 0.30 USD", sum, err)
+	}
+
+	if _, err := NewMoney(10, "USD").Add(NewMoney(20, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+
+	if _, err := NewMoney(math.MaxInt64, "USD").Add(NewMoney(1, "USD")); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("Add() error = %v, want %v", err, ErrMoneyOverflow)
+	}
+}
+
+func TestMoneySub(t *testing.T) {
+	diff, err := NewMoney(10, "USD").Sub(NewMoney(25, "USD"))
+	if err != nil || diff != NewMoney(-15, "USD") {
+		t.Errorf("Sub() = %v, %v, want -0.15 USD", diff, err)
+	}
+
+	if _, err := NewMoney(10, "USD").Sub(NewMoney(20, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sub() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+
+	if _, err := NewMoney(math.MinInt64, "USD").Sub(NewMoney(1, "USD")); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("Sub() error = %v, want %v", err, ErrMoneyOverflow)
+	}
+}
+
+func TestMoneyMultiply(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		factor   float64
+		expected int64
+	}{
+		{"tax", 1999, 1.11, 2219},
+		{"half rounds to even down", 25, 0.5, 12},
+		{"half rounds to even up", 35, 0.5, 18},
+		{"negative", -1000, 0.15, -150},
+		{"negative half", -25, 0.5, -12},
+		{"shortest decimal factor", 1000, 1.015, 1015},
+		{"shortest decimal tie", 100, 1.015, 102},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewMoney(tt.amount, "USD").Multiply(tt.factor)
+			if err != nil {
+				t.Fatalf("Multiply(%v) error = %v", tt.factor, err)
+			}
+			if result.Amount() != tt.expected {
+				t.Errorf("Multiply(%v) = %d, want %d", tt.factor, result.Amount(), tt.expected)
+			}
+		})
+	}
+
+	if _, err := NewMoney(math.MaxInt64, "USD").Multiply(2); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("Multiply() error = %v, want %v", err, ErrMoneyOverflow)
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		ratios   []int
+		expected []int64
+	}{
+		{"even thirds", 10000, []int{1, 1, 1}, []int64{3334, 3333, 3333}},
+		{"weighted", 5, []int{3, 7}, []int64{2, 3}},
+		{"negative", -10000, []int{1, 1, 1}, []int64{-3334, -3333, -3333}},
+		{"zero ratio gets nothing", 101, []int{0, 1, 1}, []int64{0, 51, 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := NewMoney(tt.amount, "USD").Allocate(tt.ratios...)
+			if err != nil {
+				t.Fatalf("Allocate(%v) error = %v", tt.ratios, err)
+			}
+			result := make([]int64, len(shares))
+			for i, share := range shares {
+				result[i] = share.Amount()
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Allocate(%v) = %v, want %v", tt.ratios, result, tt.expected)
+			}
+		})
+	}
+
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := NewMoney(100, "USD").Allocate(ratios...); err == nil {
+			t.Errorf("Allocate(%v) expected error but got nil", ratios)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		locale   *string
+		expected string
+	}{
+		{"dollars", NewMoney(123456789, "USD"), nil, "$1,234,567.89"},
+		{"negative", NewMoney(-1050, "USD"), nil, "-$10.50"},
+		{"yen", NewMoney(1050, "JPY"), nil, "¥1,050"},
+		{"dinar", NewMoney(1234, "BHD"), nil, "BHD\u00a01.234"},
+		{"large rupiah", NewMoney(9007199254740993, "IDR"), stringPtr("id"), "Rp\u00a09.007.199.254.740.993"},
+		{"locale digits", NewMoney(150, "EUR"), stringPtr("fa"), "€۱٫۵۰"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.money.Format(tt.locale); result != tt.expected {
+				t.Errorf("Format() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		money    Money
+		expected string
+	}{
+		{NewMoney(1050, "USD"), "10.50 USD"},
+		{NewMoney(-5, "USD"), "-0.05 USD"},
+		{NewMoney(1050, "JPY"), "1050 JPY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tt.money.String(); result != tt.expected {
+				t.Errorf("String() = %q, want %q", result, tt.expected)
+			}
+			if result := tt.money.Float64(); result != float64(tt.money.Amount())/math.Pow10(MinorUnits(tt.money.Currency())) {
+				t.Errorf("Float64() = %v", result)
+			}
+		})
+	}
+}
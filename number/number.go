@@ -1,11 +1,14 @@
 package number
 
 import (
-	"fmt"
 	"math"
 	"strconv"
 	"strings"
 	"sync"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	xnumber "golang.org/x/text/number"
 )
 
 var (
@@ -16,21 +19,13 @@ var (
 	// Mutex for thread-safe access to default settings
 	mu sync.RWMutex
 
-	// Currency symbols map - initialized once for better performance
-	currencySymbols = map[string]string{
-		"USD": "$",
-		"EUR": "€",
-		"GBP": "£",
-		"JPY": "¥",
-		"IDR": "Rp",
-		"INR": "₹",
-		"CNY": "¥",
-		"AUD": "A$",
-		"CAD": "C$",
-	}
-
 	// File size units - initialized once
 	fileSizeUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB", "ZB", "YB"}
+	siSizeUnits   = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB", "ZB", "YB"}
+	iecSizeUnits  = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"}
+
+	// Bit rate units
+	bitRateUnits = []string{"bps", "Kbps", "Mbps", "Gbps", "Tbps", "Pbps", "Ebps", "Zbps", "Ybps"}
 
 	// Abbreviated units for ForHumans
 	abbreviatedUnits = map[int]string{
@@ -59,63 +54,169 @@ var (
 		15: "Q",
 	}
 
-	// Replacer for Indonesian locale (comma as decimal separator)
-	idReplacer = strings.NewReplacer(".", ",")
+	// Largest number of pairs Pairs returns
+	maxPairs = float64(1 << 24)
+
+	// Printers by canonical language tag, created on first use
+	printers sync.Map
 )
 
-// Format formats the given number according to the current locale.
-// For full locale support, consider using golang.org/x/text/message
+// Format formats the given number according to the given locale using CLDR
+// data, so any BCP-47 locale gets its own grouping, decimal symbol, digit
+// system and sign placement. The precision is the minimum and maxPrecision
+// the maximum number of fraction digits, with trailing zeros trimmed in
+// between, so Format(1.5, 0, 2) gives "1.5" and Format(1.234, nil, 2) gives
+// "1.23". A precision alone fixes the number of fraction digits, and without
+// either the shortest representation of the number is used.
 func Format(number float64, precision *int, maxPrecision *int, locale *string) string {
-	loc := defaultLocale
-	if locale != nil {
-		loc = *locale
+	return formatDecimal(number, fractionRange(precision, maxPrecision), RoundNearest, localeOrDefault(locale))
+}
+
+// FormatSignificant formats the given number with at most the given number of
+// significant digits, so 1234.5 with 3 digits gives "1,230" and 0.012345
+// gives "0.0123". Trailing zeros in the fraction are trimmed.
+func FormatSignificant(number float64, significant int, locale *string) string {
+	return formatDecimal(number, digits{significant: significant}, RoundNearest, localeOrDefault(locale))
+}
+
+// digits describes how many digits a formatted number shows.
+type digits struct {
+	// Minimum and maximum fraction digits; a negative max shows as many as
+	// the shortest representation needs
+	min, max int
+	// Significant digits, used instead of min and max when positive
+	significant int
+}
+
+// fractionRange returns the digits for a minimum and a maximum number of
+// fraction digits. A precision alone is both the minimum and the maximum.
+func fractionRange(precision *int, maxPrecision *int) digits {
+	switch {
+	case precision == nil && maxPrecision == nil:
+		return digits{max: -1}
+	case maxPrecision == nil:
+		return digits{min: *precision, max: *precision}
+	case precision == nil:
+		return digits{max: *maxPrecision}
+	default:
+		return digits{min: min(*precision, *maxPrecision), max: *maxPrecision}
+	}
+}
+
+// exactly returns the digits for exactly n fraction digits.
+func exactly(n int) digits {
+	return digits{min: n, max: n}
+}
+
+// round rounds value with the given mode and returns it together with the
+// number of fraction digits to show.
+func (d digits) round(value float64, mode RoundingMode) (float64, int) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value, 0
 	}
 
-	// Basic formatting - for full locale support, use golang.org/x/text
-	var prec int
-	if maxPrecision != nil {
-		prec = *maxPrecision
-	} else if precision != nil {
-		prec = *precision
-	} else {
-		prec = -1 // Use default precision
+	if d.significant > 0 {
+		if value == 0 {
+			return 0, 0
+		}
+		exponent := int(math.Floor(math.Log10(math.Abs(value))))
+		fraction := d.significant - 1 - exponent
+		value = mode.Round(value, fraction)
+		return value, min(fractionDigits(value), max(fraction, 0))
+	}
+
+	if d.max < 0 {
+		return value, fractionDigits(value)
 	}
+	value = mode.Round(value, d.max)
+	return value, min(max(fractionDigits(value), d.min), d.max)
+}
+
+// formatDecimal rounds value to the given digits with the given mode and
+// formats it for the locale.
+func formatDecimal(value float64, d digits, mode RoundingMode, locale string) string {
+	value, fraction := d.round(value, mode)
+	return printer(locale).Sprint(decimal(value, fraction))
+}
 
-	// Simple formatting based on locale
-	if loc == "id" || strings.HasPrefix(loc, "id_") {
-		// Indonesian locale uses comma as decimal separator
-		return formatNumberID(number, prec)
+// decimal returns value with the given number of fraction digits, limited to
+// the digits of its shortest representation, so 1e23 is shown as
+// 100,000,000,000,000,000,000,000 rather than in its binary expansion.
+func decimal(value float64, fraction int) xnumber.Formatter {
+	return xnumber.Decimal(value,
+		xnumber.MinFractionDigits(fraction),
+		xnumber.MaxFractionDigits(fraction),
+		xnumber.Precision(significantDigits(value)),
+	)
+}
+
+// significantDigits returns the number of significant digits in the shortest
+// representation of value, or -1, meaning all, when it is not finite.
+func significantDigits(value float64) int {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return -1
 	}
+	mantissa, _, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'e', -1, 64), "e")
+	return len(mantissa) - strings.Count(mantissa, ".")
+}
 
-	// Default (English) formatting
-	return formatNumberEN(number, prec)
+// fractionDigits returns the number of fraction digits in the shortest
+// representation of value.
+func fractionDigits(value float64) int {
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 {
+		return len(formatted) - dot - 1
+	}
+	return 0
 }
 
-func formatNumberEN(number float64, precision int) string {
-	if precision < 0 {
-		return strconv.FormatFloat(number, 'f', -1, 64)
+// localeTag parses a locale such as "id", "id_ID" or "de-CH" into a language
+// tag. Only the language, script, region and numbering system are kept, as
+// nothing else changes how numbers are written, so variants, private use and
+// other extensions can't grow the per-locale caches without bound.
+func localeTag(locale string) language.Tag {
+	tag := language.Make(strings.ReplaceAll(locale, "_", "-"))
+	base, script, region := tag.Raw()
+	stripped, _ := language.Compose(base, script, region)
+	if nu := tag.TypeForKey("nu"); nu != "" {
+		if withNu, err := stripped.SetTypeForKey("nu", nu); err == nil {
+			stripped = withNu
+		}
 	}
-	return strconv.FormatFloat(number, 'f', precision, 64)
+	return stripped
+}
+
+// localeKey returns the canonical language tag of a locale, used as the key
+// of the per-locale caches so that spellings such as "en_us" and "en-US"
+// share one entry.
+func localeKey(locale string) string {
+	return localeTag(locale).String()
 }
 
-func formatNumberID(number float64, precision int) string {
-	var formatted string
-	if precision < 0 {
-		formatted = strconv.FormatFloat(number, 'f', -1, 64)
-	} else {
-		formatted = strconv.FormatFloat(number, 'f', precision, 64)
+// printer returns the message printer for the given locale.
+func printer(locale string) *message.Printer {
+	tag := localeTag(locale)
+	key := tag.String()
+	if p, ok := printers.Load(key); ok {
+		return p.(*message.Printer)
 	}
-	return idReplacer.Replace(formatted)
+	p, _ := printers.LoadOrStore(key, message.NewPrinter(tag))
+	return p.(*message.Printer)
+}
+
+// minusSign returns the symbol the locale puts in front of negative numbers.
+func minusSign(locale string) string {
+	p := printer(locale)
+	return strings.TrimSuffix(p.Sprint(xnumber.Decimal(-1)), p.Sprint(xnumber.Decimal(1)))
 }
 
-// Spell spells out the given number in the given locale.
-// Note: Full spellout support requires external library like golang.org/x/text
+// Spell spells out the given number in words in the given locale, such as
+// "forty-two" in English or "empat puluh dua" in Indonesian. Numbers at or
+// below after, or at or above until, are formatted as digits instead, as are
+// numbers in locales without a registered Speller.
 func Spell(number float64, locale *string, after *int, until *int) string {
 	// Get locale once
-	loc := defaultLocale
-	if locale != nil {
-		loc = *locale
-	}
+	loc := localeOrDefault(locale)
 
 	if after != nil && number <= float64(*after) {
 		return Format(number, nil, nil, &loc)
@@ -125,195 +226,277 @@ func Spell(number float64, locale *string, after *int, until *int) string {
 		return Format(number, nil, nil, &loc)
 	}
 
-	// Basic spellout - for full support, use golang.org/x/text
-	return spelloutBasic(number)
-}
-
-func spelloutBasic(number float64) string {
-	// Very basic implementation
-	// For production, use golang.org/x/text/message
-	if number == 0 {
-		return "zero"
+	speller, ok := spellerFor(loc)
+	if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+		return Format(number, nil, nil, &loc)
 	}
-	return fmt.Sprintf("%.0f", number)
+	return spellNumber(speller, number)
 }
 
-// Ordinal converts the given number to ordinal form (1st, 2nd, 3rd, etc.)
+// Ordinal converts the given number to its ordinal form in the given locale,
+// such as "1st" in English, "ke-1" in Indonesian, "1er" in French or "1." in
+// German. Any fraction is dropped and the digits are grouped as in Format, so
+// values beyond the range of int64 keep all of their digits. Negative numbers
+// put the sign before the digits, or wrap both in parentheses after a prefix,
+// as in "ke-(-1)".
 func Ordinal(number float64, locale *string) string {
-	n := int64(number)
-	suffix := getOrdinalSuffix(n)
-	return fmt.Sprintf("%d%s", n, suffix)
-}
+	loc := localeOrDefault(locale)
 
-func getOrdinalSuffix(n int64) string {
-	if n < 0 {
-		n = -n
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return Format(number, nil, nil, &loc)
 	}
 
-	lastDigit := n % 10
-	lastTwoDigits := n % 100
-
-	if lastTwoDigits >= 11 && lastTwoDigits <= 13 {
-		return "th"
-	}
+	whole := math.Trunc(math.Abs(number))
+	prefix, suffix := ordinalFor(loc)(strconv.FormatFloat(whole, 'f', 0, 64))
 
-	switch lastDigit {
-	case 1:
-		return "st"
-	case 2:
-		return "nd"
-	case 3:
-		return "rd"
+	digits := formatDecimal(whole, exactly(0), RoundNearest, loc)
+	switch {
+	case number > -1:
+		return prefix + digits + suffix
+	case prefix != "":
+		// Keep the sign with the digits, as in "ke-(-1)" rather than "-ke-1"
+		return prefix + "(" + minusSign(loc) + digits + ")" + suffix
 	default:
-		return "th"
+		return minusSign(loc) + digits + suffix
 	}
 }
 
-// SpellOrdinal spells out the given number in ordinal form
+// SpellOrdinal spells out the given number in ordinal form, such as "first"
+// or "twenty-second" in English and "pertama" or "kedua" in Indonesian.
+// Locales without an OrdinalSpeller fall back to Ordinal.
 func SpellOrdinal(number float64, locale *string) string {
-	// Basic implementation
-	ord := Ordinal(number, locale)
-	return fmt.Sprintf("%s ordinal", ord)
+	loc := localeOrDefault(locale)
+
+	speller, _ := spellerFor(loc)
+	ordinal, ok := speller.(OrdinalSpeller)
+	if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+		return Ordinal(number, &loc)
+	}
+
+	words := ordinal.SpellOrdinal(strconv.FormatFloat(math.Trunc(math.Abs(number)), 'f', 0, 64))
+	if number <= -1 {
+		words = ordinal.Minus() + " " + words
+	}
+	return words
 }
 
-// Percentage converts the given number to its percentage equivalent
+// Percentage converts the given number to its percentage equivalent. The
+// number is divided by 100 before it is shown, so 50 with a precision of 2
+// becomes "0.50%" in English and "0,50 %" in German. The precision and
+// maxPrecision are the minimum and maximum fraction digits, as in Format.
 func Percentage(number float64, precision int, maxPrecision *int, locale *string) string {
-	percentage := number / 100.0
+	return percentage(number, fractionRange(&precision, maxPrecision), RoundNearest, localeOrDefault(locale))
+}
 
-	var prec int
-	if maxPrecision != nil {
-		prec = *maxPrecision
-	} else {
-		prec = precision
+// percentage formats number as a percentage, rounding it with the given mode.
+func percentage(number float64, d digits, mode RoundingMode, loc string) string {
+	if text, ok := (NonFinite{}).text(number, loc); ok {
+		return text
 	}
 
-	formatted := Format(percentage, &prec, nil, locale)
-	return formatted + "%"
+	rounded, fraction := d.round(number/100.0, mode)
+	// Percent multiplies by 100 again to show the rounded value
+	return printer(loc).Sprint(xnumber.Percent(rounded/100.0,
+		xnumber.MinFractionDigits(fraction),
+		xnumber.MaxFractionDigits(fraction),
+		xnumber.Precision(significantDigits(rounded)),
+	))
 }
 
-// Currency converts the given number to its currency equivalent
+// Currency converts the given number to its currency equivalent. The symbol,
+// its position and spacing, and the format of negative amounts follow the
+// locale, and the precision defaults to the minor units of the currency.
 func Currency(number float64, in string, locale *string, precision *int) string {
-	loc := defaultLocale
-	if locale != nil {
-		loc = *locale
-	}
+	return formatAmount(number, in, precision, nil, RoundNearest, localeOrDefault(locale))
+}
 
-	curr := defaultCurrency
-	if in != "" {
-		curr = in
+// formatAmount formats number as an amount of money, rounding it with the
+// given mode. Without a precision or maxPrecision it shows exactly the minor
+// units of the currency.
+func formatAmount(number float64, in string, precision *int, maxPrecision *int, mode RoundingMode, loc string) string {
+	if text, ok := (NonFinite{}).text(number, loc); ok {
+		return text
 	}
 
-	var prec int
-	if precision != nil {
-		prec = *precision
-	} else {
-		prec = 2 // Default currency precision
+	curr := in
+	if curr == "" {
+		curr = DefaultCurrency()
 	}
 
-	formatted := Format(number, &prec, nil, &loc)
-
-	// Add currency symbol
-	symbol := getCurrencySymbol(curr)
-	// Indonesian locale uses space between symbol and number
-	if len(loc) >= 2 && loc[:2] == "id" {
-		return symbol + " " + formatted
+	d := fractionRange(precision, maxPrecision)
+	if precision == nil && maxPrecision == nil {
+		d = exactly(MinorUnits(curr)) // Default currency precision
 	}
-	return symbol + formatted
+
+	rounded, _ := d.round(number, mode)
+	formatted := formatDecimal(math.Abs(rounded), d, mode, loc)
+	return formatCurrency(formatted, rounded < 0, curr, loc)
 }
 
-func getCurrencySymbol(currency string) string {
-	if symbol, ok := currencySymbols[currency]; ok {
-		return symbol
+// SizeStandard selects the units and the base used by FileSizeWith.
+type SizeStandard int
+
+const (
+	// SizeJEDEC uses powers of 1024 labelled KB, MB, GB and so on, as FileSize does.
+	SizeJEDEC SizeStandard = iota
+	// SizeSI uses powers of 1000 labelled kB, MB, GB and so on.
+	SizeSI
+	// SizeIEC uses powers of 1024 labelled KiB, MiB, GiB and so on.
+	SizeIEC
+)
+
+// units returns the units of the standard and the factor between them.
+func (s SizeStandard) units() ([]string, float64) {
+	switch s {
+	case SizeSI:
+		return siSizeUnits, 1000
+	case SizeIEC:
+		return iecSizeUnits, 1024
+	default:
+		return fileSizeUnits, 1024
 	}
-	return currency + " "
 }
 
-// FileSize converts the given number to its file size equivalent
+// FileSize converts the given number to its file size equivalent in powers of
+// 1024 labelled KB, MB and so on. The precision and maxPrecision are the
+// minimum and maximum fraction digits, as in Format.
 func FileSize(bytes float64, precision int, maxPrecision *int) string {
-	i := 0
-	size := bytes
-	for size >= 1024 && i < len(fileSizeUnits)-1 {
-		size /= 1024
-		i++
+	return fileSize(bytes, SizeJEDEC, fractionRange(&precision, maxPrecision), RoundNearest, DefaultLocale())
+}
+
+// FileSizeWith converts the given number to its file size equivalent using the
+// units of the given standard and the decimals of the given locale, so 1500
+// bytes is "1.5 kB" in SI and 1536 bytes is "1,5 KiB" in IEC for German.
+func FileSizeWith(bytes float64, standard SizeStandard, precision int, maxPrecision *int, locale *string) string {
+	return fileSize(bytes, standard, fractionRange(&precision, maxPrecision), RoundNearest, localeOrDefault(locale))
+}
+
+// BitRate converts the given number of bits per second to a rate in powers of
+// 1000, such as "1.5 Mbps".
+func BitRate(bitsPerSecond float64, precision int, maxPrecision *int, locale *string) string {
+	return bitRate(bitsPerSecond, fractionRange(&precision, maxPrecision), RoundNearest, localeOrDefault(locale))
+}
+
+// bitRate formats bits per second in powers of 1000.
+func bitRate(bitsPerSecond float64, d digits, mode RoundingMode, locale string) string {
+	return scaleUnits(bitsPerSecond, 1000, bitRateUnits, d, mode, locale)
+}
+
+// fileSize formats bytes in the units of the given standard.
+func fileSize(bytes float64, standard SizeStandard, d digits, mode RoundingMode, locale string) string {
+	units, base := standard.units()
+	return scaleUnits(bytes, base, units, d, mode, locale)
+}
+
+// scaleUnits formats value in the largest of units, each base times the one
+// before, that keeps its magnitude at least 1, with the digits and separators
+// of the given locale. A value that rounds up to base moves to the next unit.
+func scaleUnits(value, base float64, units []string, d digits, mode RoundingMode, locale string) string {
+	if text, ok := (NonFinite{}).text(value, locale); ok {
+		return text
 	}
 
-	var prec int
-	if maxPrecision != nil {
-		prec = *maxPrecision
-	} else {
-		prec = precision
+	i := 0
+	for i < len(units)-1 {
+		if rounded, _ := d.round(value, mode); math.Abs(rounded) < base {
+			break
+		}
+		value /= base
+		i++
 	}
 
-	formatted := Format(size, &prec, nil, nil)
-	return formatted + " " + fileSizeUnits[i]
+	return formatDecimal(value, d, mode, locale) + " " + units[i]
 }
 
 // Abbreviate converts the number to its human-readable abbreviated equivalent
+// using the units of the default locale, such as "1.5K" in English or
+// "1,5 jt" in Indonesian.
 func Abbreviate(number float64, precision int, maxPrecision *int) string {
 	return ForHumans(number, precision, maxPrecision, true)
 }
 
-// ForHumans converts the number to its human-readable equivalent
+// AbbreviateIn converts the number to its human-readable abbreviated
+// equivalent as Abbreviate does, using the units of the given locale.
+func AbbreviateIn(number float64, precision int, maxPrecision *int, locale *string) string {
+	return ForHumansIn(number, precision, maxPrecision, true, locale)
+}
+
+// ForHumans converts the number to its human-readable equivalent using the
+// units of the default locale, such as "1.5 thousand" in English, "2 juta" in
+// Indonesian, "1.5万" in Japanese or "3 lakh" in Indian English. Units are
+// pluralised where the language needs it, as in "2 millions" in French.
 func ForHumans(number float64, precision int, maxPrecision *int, abbreviate bool) string {
-	if abbreviate {
-		return Summarize(number, precision, maxPrecision, abbreviatedUnits)
-	}
-	return Summarize(number, precision, maxPrecision, fullUnits)
+	return ForHumansIn(number, precision, maxPrecision, abbreviate, nil)
+}
+
+// ForHumansIn converts the number to its human-readable equivalent as
+// ForHumans does, using the units of the given locale.
+func ForHumansIn(number float64, precision int, maxPrecision *int, abbreviate bool, locale *string) string {
+	loc := localeOrDefault(locale)
+	return summarize(number, fractionRange(&precision, maxPrecision), humanUnitsOf(loc, abbreviate), RoundNearest, loc)
 }
 
-// Summarize converts the number to its human-readable equivalent with custom units
+// Summarize converts the number to its human-readable equivalent with custom
+// units keyed by exponent. The precision and maxPrecision are the minimum and
+// maximum fraction digits, as in Format.
 func Summarize(number float64, precision int, maxPrecision *int, units map[int]string) string {
 	if len(units) == 0 {
 		units = defaultUnits
 	}
+	return summarize(number, fractionRange(&precision, maxPrecision), unitsFromMap(units), RoundNearest, DefaultLocale())
+}
+
+// summarize formats number in the largest of units not above it, with the
+// digits and separators of the given locale, rounding with the given mode. A
+// number that rounds up to the next unit moves to it, so 999,999 becomes "1M"
+// rather than "1000K". Numbers beyond the largest unit stay in it with their
+// digits grouped, as in "1,000Q", rather than repeating its suffix.
+func summarize(number float64, d digits, units []HumanUnit, mode RoundingMode, locale string) string {
+	if text, ok := (NonFinite{}).text(number, locale); ok {
+		return text
+	}
 
 	if number == 0.0 {
-		if precision > 0 {
-			prec := precision
-			return Format(0, &prec, maxPrecision, nil)
-		}
-		return "0"
+		return formatDecimal(0, d, mode, locale)
 	}
 
 	if number < 0 {
-		return "-" + Summarize(-number, precision, maxPrecision, units)
+		return minusSign(locale) + summarize(-number, d, units, mode.mirror(), locale)
 	}
 
-	if number >= 1e15 {
-		// Find the largest unit
-		maxExp := 15
-		for exp := range units {
-			if exp > maxExp {
-				maxExp = exp
-			}
-		}
-		unit, exists := units[maxExp]
-		if !exists {
-			// Fallback to default unit if maxExp not in units
-			unit = "Q"
-		}
-		formatted := Summarize(number/1e15, precision, maxPrecision, units)
-		return strings.TrimSpace(formatted + unit)
+	// Index of the unit to use, or -1 for none
+	i := -1
+	exponent := int(math.Floor(math.Log10(number)))
+	for i+1 < len(units) && units[i+1].Exponent <= exponent {
+		i++
 	}
-
-	numberExponent := int(math.Floor(math.Log10(number)))
-	displayExponent := numberExponent - (numberExponent % 3)
-	number /= math.Pow(10, float64(displayExponent))
-
-	var prec int
-	if maxPrecision != nil {
-		prec = *maxPrecision
-	} else {
-		prec = precision
+	if i+1 < len(units) && reaches(number, d, mode, unitExponent(units, i), units[i+1].Exponent) {
+		i++
+	}
+	if i < 0 {
+		return formatDecimal(number, d, mode, locale)
 	}
 
-	formatted := Format(number, &prec, nil, nil)
-	unit, exists := units[displayExponent]
-	if !exists || unit == "" {
-		return formatted
+	unit := units[i]
+	scaled := number / math.Pow10(unit.Exponent)
+	rounded, fraction := d.round(scaled, mode)
+	formatted := formatDecimal(scaled, d, mode, locale)
+	return strings.TrimSpace(formatted + unit.text(pluralForm(locale, rounded, fraction)))
+}
+
+// unitExponent returns the exponent of units[i], or 0 before the first unit.
+func unitExponent(units []HumanUnit, i int) int {
+	if i < 0 {
+		return 0
 	}
+	return units[i].Exponent
+}
 
-	return strings.TrimSpace(formatted + unit)
+// reaches reports whether number shown in the unit at exponent from rounds up
+// to at least one of the unit at exponent to.
+func reaches(number float64, d digits, mode RoundingMode, from, to int) bool {
+	rounded, _ := d.round(number/math.Pow10(from), mode)
+	return rounded >= math.Pow10(to-from)
 }
 
 // Clamp clamps the given number between the given minimum and maximum
@@ -327,8 +510,14 @@ func Clamp(number, min, max float64) float64 {
 	return number
 }
 
-// Pairs splits the given number into pairs of min/max values
+// Pairs splits the given number into pairs of min/max values. It returns nil
+// when by is not positive or when there would be more than maxPairs pairs,
+// which includes an infinite to, so it always terminates.
 func Pairs(to, by, offset float64) [][]float64 {
+	if !(by > 0) || to/by > maxPairs {
+		return nil
+	}
+
 	var output [][]float64
 
 	for lower := 0.0; lower < to; lower += by {
@@ -355,7 +544,9 @@ func Trim(number float64) float64 {
 	return trimmed
 }
 
-// WithLocale executes the given callback using the given locale
+// WithLocale executes the given callback using the given locale. It changes
+// the process-wide default for the duration of the callback, so concurrent
+// callers with different locales should use a Formatter instead.
 func WithLocale(locale string, callback func()) {
 	mu.Lock()
 	previousLocale := defaultLocale
@@ -371,7 +562,9 @@ func WithLocale(locale string, callback func()) {
 	callback()
 }
 
-// WithCurrency executes the given callback using the given currency
+// WithCurrency executes the given callback using the given currency. It
+// changes the process-wide default for the duration of the callback, so
+// concurrent callers with different currencies should use a Formatter instead.
 func WithCurrency(currency string, callback func()) {
 	mu.Lock()
 	previousCurrency := defaultCurrency
@@ -401,6 +594,14 @@ func UseCurrency(currency string) {
 	defaultCurrency = currency
 }
 
+// localeOrDefault returns the given locale, or the default locale when it is nil.
+func localeOrDefault(locale *string) string {
+	if locale != nil {
+		return *locale
+	}
+	return DefaultLocale()
+}
+
 // DefaultLocale returns the default locale
 func DefaultLocale() string {
 	mu.RLock()
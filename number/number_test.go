@@ -1,6 +1,9 @@
 package number
 
 import (
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -16,15 +19,30 @@ func TestFormat(t *testing.T) {
 		{"basic default", 123.456, nil, nil, nil, "123.456"},
 		{"with precision", 123.456, intPtr(2), nil, nil, "123.46"},
 		{"with max precision", 123.456, nil, intPtr(2), nil, "123.46"},
-		{"max precision overrides precision", 123.456, intPtr(1), intPtr(2), nil, "123.46"},
+		{"min and max precision", 123.456, intPtr(1), intPtr(2), nil, "123.46"},
+		{"max precision trims zeros", 1.5, intPtr(0), intPtr(2), nil, "1.5"},
+		{"max precision alone", 1.234, nil, intPtr(2), nil, "1.23"},
+		{"max precision on whole number", 7.0, nil, intPtr(2), nil, "7"},
+		{"min precision pads zeros", 7.0, intPtr(1), intPtr(3), nil, "7.0"},
+		{"precision alone is fixed", 1.5, intPtr(2), nil, nil, "1.50"},
+		{"min above max", 1.5, intPtr(3), intPtr(1), nil, "1.5"},
 		{"indonesian locale", 123.456, nil, nil, stringPtr("id"), "123,456"},
 		{"indonesian with precision", 123.456, intPtr(2), nil, stringPtr("id"), "123,46"},
 		{"indonesian prefix locale", 123.456, nil, nil, stringPtr("id_ID"), "123,456"},
 		{"zero", 0.0, nil, nil, nil, "0"},
 		{"negative", -123.456, nil, nil, nil, "-123.456"},
-		{"large number", 1234567.89, nil, nil, nil, "1234567.89"},
+		{"large number", 1234567.89, nil, nil, nil, "1,234,567.89"},
+		{"indonesian grouping", 1234567.891, intPtr(2), nil, stringPtr("id"), "1.234.567,89"},
+		{"german grouping", 1234567.8, nil, nil, stringPtr("de"), "1.234.567,8"},
+		{"swiss grouping", 1234567.8, nil, nil, stringPtr("de-CH"), "1’234’567.8"},
+		{"indian grouping", 1234567.0, nil, nil, stringPtr("en-IN"), "12,34,567"},
+		{"persian digits", -1234.5, nil, nil, stringPtr("fa"), "\u200e−۱٬۲۳۴٫۵"},
+		{"underscore region", 1234.5, nil, nil, stringPtr("de_DE"), "1.234,5"},
 		{"small number", 0.001, nil, nil, nil, "0.001"},
 		{"no decimal", 123.0, nil, nil, nil, "123"},
+		{"shortest digits of 1e23", 1e23, nil, nil, nil, "100,000,000,000,000,000,000,000"},
+		{"shortest digits of 1.5e25", 1.5e25, nil, nil, nil, "15,000,000,000,000,000,000,000,000"},
+		{"shortest digits with precision", 1e23, intPtr(2), nil, nil, "100,000,000,000,000,000,000,000.00"},
 	}
 
 	for _, tt := range tests {
@@ -46,14 +64,29 @@ func TestSpell(t *testing.T) {
 		until    *int
 		expected string
 	}{
-		{"basic", 123.0, nil, nil, nil, "123"},
+		{"basic", 123.0, nil, nil, nil, "one hundred twenty-three"},
 		{"zero", 0.0, nil, nil, nil, "zero"},
+		{"teens", 42.0, nil, nil, nil, "forty-two"},
+		{"round tens", 90.0, nil, nil, nil, "ninety"},
+		{"scales", 1002003.0, nil, nil, nil, "one million two thousand three"},
+		{"decimal", 3.14, nil, nil, nil, "three point one four"},
+		{"beyond largest scale", 1e36, nil, nil, nil, "one thousand decillion"},
 		{"with after threshold", 5.0, nil, intPtr(10), nil, "5"},
-		{"above after threshold", 15.0, nil, intPtr(10), nil, "15"},
-		{"with until threshold", 5.0, nil, nil, intPtr(10), "5"},
+		{"above after threshold", 15.0, nil, intPtr(10), nil, "fifteen"},
+		{"with until threshold", 5.0, nil, nil, intPtr(10), "five"},
 		{"above until threshold", 15.0, nil, nil, intPtr(10), "15"},
-		{"with locale", 123.0, stringPtr("id"), nil, nil, "123"},
-		{"negative", -123.0, nil, nil, nil, "-123"},
+		{"threshold uses locale digits", 1500.5, stringPtr("id"), nil, intPtr(10), "1.500,5"},
+		{"with locale", 123.0, stringPtr("id"), nil, nil, "seratus dua puluh tiga"},
+		{"indonesian teens", 42.0, stringPtr("id"), nil, nil, "empat puluh dua"},
+		{"indonesian se- prefix", 1111.0, stringPtr("id"), nil, nil, "seribu seratus sebelas"},
+		{"indonesian belas", 15000.0, stringPtr("id_ID"), nil, nil, "lima belas ribu"},
+		{"indonesian one million", 1000000.0, stringPtr("id"), nil, nil, "satu juta"},
+		{"indonesian decimal", -1.05, stringPtr("id"), nil, nil, "minus satu koma nol lima"},
+		{"negative", -123.0, nil, nil, nil, "minus one hundred twenty-three"},
+		{"regional variant", 21.0, stringPtr("en-GB"), nil, nil, "twenty-one"},
+		{"unsupported locale", 1234.0, stringPtr("de"), nil, nil, "1.234"},
+		{"undetermined locale", 1234.0, stringPtr(""), nil, nil, "1,234"},
+		{"parent locale", 21.0, stringPtr("en-IN"), nil, nil, "twenty-one"},
 	}
 
 	for _, tt := range tests {
@@ -86,8 +119,20 @@ func TestOrdinal(t *testing.T) {
 		{"hundredth", 100.0, nil, "100th"},
 		{"negative", -1.0, nil, "-1st"},
 		{"zero", 0.0, nil, "0th"},
-		{"with locale", 1.0, stringPtr("id"), "1st"},
 		{"decimal rounds down", 1.9, nil, "1st"},
+		{"hundred eleventh", 111.0, nil, "111th"},
+		{"beyond int64", 1e19, nil, "10,000,000,000,000,000,000th"},
+		{"indonesian", 1.0, stringPtr("id"), "ke-1"},
+		{"indonesian region", 10.0, stringPtr("id_ID"), "ke-10"},
+		{"indonesian negative", -1.0, stringPtr("id"), "ke-(-1)"},
+		{"indonesian large", 1e15, stringPtr("id"), "ke-1.000.000.000.000.000"},
+		{"french first", 1.0, stringPtr("fr"), "1er"},
+		{"french second", 2.0, stringPtr("fr"), "2e"},
+		{"french eleventh", 11.0, stringPtr("fr"), "11e"},
+		{"german", 3.0, stringPtr("de"), "3."},
+		{"german grouping", 1000.0, stringPtr("de"), "1.000."},
+		{"german negative", -2.0, stringPtr("de"), "-2."},
+		{"unknown locale uses english", 2.0, stringPtr("xx"), "2nd"},
 	}
 
 	for _, tt := range tests {
@@ -107,9 +152,23 @@ func TestSpellOrdinal(t *testing.T) {
 		locale   *string
 		expected string
 	}{
-		{"basic", 1.0, nil, "1st ordinal"},
-		{"second", 2.0, nil, "2nd ordinal"},
-		{"with locale", 1.0, stringPtr("id"), "1st ordinal"},
+		{"basic", 1.0, nil, "first"},
+		{"second", 2.0, nil, "second"},
+		{"twelfth", 12.0, nil, "twelfth"},
+		{"twentieth", 20.0, nil, "twentieth"},
+		{"twenty-second", 22.0, nil, "twenty-second"},
+		{"hundredth", 100.0, nil, "one hundredth"},
+		{"millionth", 1000000.0, nil, "one millionth"},
+		{"negative", -3.0, nil, "minus third"},
+		{"decimal rounds down", 5.7, nil, "fifth"},
+		{"indonesian first", 1.0, stringPtr("id"), "pertama"},
+		{"indonesian second", 2.0, stringPtr("id"), "kedua"},
+		{"indonesian eleventh", 11.0, stringPtr("id"), "kesebelas"},
+		{"indonesian hundredth", 100.0, stringPtr("id"), "keseratus"},
+		{"indonesian beyond satu", 1e15, stringPtr("id"), "ke-satu kuadriliun"},
+		{"indonesian twenty-first", 21.0, stringPtr("id"), "kedua puluh satu"},
+		{"indonesian negative", -2.0, stringPtr("id"), "minus kedua"},
+		{"without ordinal speller", 1.0, stringPtr("fr"), "1er"},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +181,33 @@ func TestSpellOrdinal(t *testing.T) {
 	}
 }
 
+func TestFormatSignificant(t *testing.T) {
+	tests := []struct {
+		name        string
+		number      float64
+		significant int
+		locale      *string
+		expected    string
+	}{
+		{"large number", 1234.5, 3, nil, "1,230"},
+		{"small number", 0.012345, 3, nil, "0.0123"},
+		{"trims zeros", 1.5, 4, nil, "1.5"},
+		{"rounds up", 9.996, 3, nil, "10"},
+		{"negative", -98765.0, 2, nil, "-99,000"},
+		{"zero", 0.0, 3, nil, "0"},
+		{"locale", 1234.5, 5, stringPtr("de"), "1.234,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FormatSignificant(tt.number, tt.significant, tt.locale)
+			if result != tt.expected {
+				t.Errorf("FormatSignificant(%f, %d, %v) = %q, want %q", tt.number, tt.significant, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestPercentage(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -135,10 +221,14 @@ func TestPercentage(t *testing.T) {
 		{"with precision", 50.0, 1, nil, nil, "0.5%"},
 		{"with max precision", 50.0, 3, intPtr(2), nil, "0.50%"},
 		{"indonesian locale", 50.0, 2, nil, stringPtr("id"), "0,50%"},
+		{"german locale", 5000.0, 0, nil, stringPtr("de"), "50\u00a0%"},
+		{"turkish locale", 5000.0, 0, nil, stringPtr("tr"), "%50"},
 		{"zero", 0.0, 2, nil, nil, "0.00%"},
 		{"hundred", 100.0, 2, nil, nil, "1.00%"},
 		{"negative", -50.0, 2, nil, nil, "-0.50%"},
 		{"small value", 0.5, 2, nil, nil, "0.01%"},
+		{"grouped", 12345600.0, 0, nil, nil, "123,456%"},
+		{"min and max precision", 1250.0, 0, intPtr(2), nil, "12.5%"},
 	}
 
 	for _, tt := range tests {
@@ -163,14 +253,29 @@ func TestCurrency(t *testing.T) {
 		{"basic USD", 123.45, "USD", nil, nil, "$123.45"},
 		{"EUR", 123.45, "EUR", nil, nil, "€123.45"},
 		{"GBP", 123.45, "GBP", nil, nil, "£123.45"},
-		{"IDR", 123.45, "IDR", nil, nil, "Rp123.45"},
-		{"IDR indonesian locale", 123.45, "IDR", stringPtr("id"), nil, "Rp 123,45"},
+		{"IDR", 123.45, "IDR", nil, nil, "IDR\u00a0123"},
+		{"IDR indonesian locale", 123.45, "IDR", stringPtr("id"), nil, "Rp\u00a0123"},
 		{"with precision", 123.456, "USD", nil, intPtr(3), "$123.456"},
 		{"default currency", 123.45, "", nil, nil, "$123.45"},
-		{"unknown currency", 123.45, "XYZ", nil, nil, "XYZ 123.45"},
+		{"unknown currency", 123.45, "XYZ", nil, nil, "XYZ\u00a0123.45"},
 		{"zero", 0.0, "USD", nil, nil, "$0.00"},
-		{"negative", -123.45, "USD", nil, nil, "$-123.45"},
-		{"indonesian locale USD", 123.45, "USD", stringPtr("id"), nil, "$ 123,45"},
+		{"negative", -123.45, "USD", nil, nil, "-$123.45"},
+		{"negative rounding to zero", -0.001, "USD", nil, nil, "$0.00"},
+		{"grouped", 1234567.891, "USD", nil, nil, "$1,234,567.89"},
+		{"grouped indonesian", 1234567.891, "IDR", stringPtr("id"), intPtr(2), "Rp\u00a01.234.567,89"},
+		{"indonesian locale USD", 123.45, "USD", stringPtr("id"), nil, "US$\u00a0123,45"},
+		{"yen has no minor units", 1050.4, "JPY", nil, nil, "¥1,050"},
+		{"dinar has three minor units", 1.2345, "BHD", nil, nil, "BHD\u00a01.234"},
+		{"german symbol after", -5.0, "EUR", stringPtr("de"), nil, "-5,00\u00a0€"},
+		{"swiss symbol before", 5.0, "CHF", stringPtr("de-CH"), nil, "CHF\u00a05.00"},
+		{"dutch sign after symbol", -5.0, "EUR", stringPtr("nl"), nil, "€\u00a0-5,00"},
+		{"spanish symbol after", 5.0, "EUR", stringPtr("es-ES"), nil, "5,00\u00a0€"},
+		{"latin american spanish symbol before", 5.0, "USD", stringPtr("es-419"), nil, "USD\u00a05.00"},
+		{"mexican spanish inherits latin american", 5.0, "MXN", stringPtr("es-MX"), nil, "$5.00"},
+		{"argentinian spanish with space", 5.0, "ARS", stringPtr("es-AR"), nil, "$\u00a05,00"},
+		{"angolan portuguese inherits european", 5.0, "EUR", stringPtr("pt-AO"), nil, "5,00\u00a0€"},
+		{"australian dollar", 5.0, "AUD", nil, nil, "A$5.00"},
+		{"any iso currency", 5.0, "THB", nil, nil, "THB\u00a05.00"},
 	}
 
 	for _, tt := range tests {
@@ -198,9 +303,13 @@ func TestFileSize(t *testing.T) {
 		{"terabytes", 1099511627776.0, 2, nil, "1.00 TB"},
 		{"with precision", 1536.0, 1, nil, "1.5 KB"},
 		{"with max precision", 1536.0, 3, intPtr(1), "1.5 KB"},
+		{"max precision trims zeros", 1024.0, 0, intPtr(2), "1 KB"},
 		{"large value", 1024.0 * 1024 * 1024 * 1024, 2, nil, "1.00 TB"},
 		{"zero", 0.0, 0, nil, "0 B"},
 		{"fractional KB", 512.0, 2, nil, "512.00 B"},
+		{"grouped bytes", 1023.5, 2, nil, "1,023.50 B"},
+		{"rounds up to next unit", 1048575.0, 1, nil, "1.0 MB"},
+		{"negative", -1536.0, 1, nil, "-1.5 KB"},
 	}
 
 	for _, tt := range tests {
@@ -213,6 +322,57 @@ func TestFileSize(t *testing.T) {
 	}
 }
 
+func TestFileSizeWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		bytes    float64
+		standard SizeStandard
+		locale   *string
+		expected string
+	}{
+		{"si kilobytes", 1500, SizeSI, nil, "1.5 kB"},
+		{"si megabytes", 2500000, SizeSI, nil, "2.5 MB"},
+		{"iec kibibytes", 1536, SizeIEC, nil, "1.5 KiB"},
+		{"iec gibibytes", 1.5 * 1024 * 1024 * 1024, SizeIEC, nil, "1.5 GiB"},
+		{"jedec kilobytes", 1536, SizeJEDEC, nil, "1.5 KB"},
+		{"bytes", 999, SizeSI, nil, "999 B"},
+		{"negative", -1500, SizeSI, nil, "-1.5 kB"},
+		{"locale decimals", 1536, SizeIEC, stringPtr("de"), "1,5 KiB"},
+		{"locale minus", -1536, SizeIEC, stringPtr("fa"), "\u200e−۱٫۵ KiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FileSizeWith(tt.bytes, tt.standard, 0, intPtr(2), tt.locale)
+			if result != tt.expected {
+				t.Errorf("FileSizeWith(%f, %d) = %q, want %q", tt.bytes, tt.standard, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBitRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		bits     float64
+		expected string
+	}{
+		{"bits", 500, "500 bps"},
+		{"kilobits", 1500, "1.5 Kbps"},
+		{"megabits", 100e6, "100 Mbps"},
+		{"gigabits", 2.5e9, "2.5 Gbps"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := BitRate(tt.bits, 0, intPtr(1), nil)
+			if result != tt.expected {
+				t.Errorf("BitRate(%f) = %q, want %q", tt.bits, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestAbbreviate(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -290,6 +450,10 @@ func TestSummarize(t *testing.T) {
 		{"with precision", 1500.0, 1, nil, nil, "1.5K"},
 		{"empty units map", 1000.0, 0, nil, map[int]string{}, "1K"},
 		{"number without unit match", 500.0, 0, nil, nil, "500"},
+		{"rounds up to next unit", 999999.0, 0, nil, nil, "1M"},
+		{"max precision trims zeros", 1250.0, 0, intPtr(2), nil, "1.25K"},
+		{"min precision pads zeros", 2000.0, 1, intPtr(2), nil, "2.0K"},
+		{"rounds up to largest unit", 999999999999999.0, 1, nil, nil, "1.0Q"},
 	}
 
 	for _, tt := range tests {
@@ -344,6 +508,12 @@ func TestPairs(t *testing.T) {
 		{"small range", 2.0, 1.0, 0.0, [][]float64{{0.0, 1.0}, {1.0, 2.0}}},
 		{"zero to", 0.0, 1.0, 0.0, [][]float64{}},
 		{"decimal step", 5.0, 1.5, 0.0, [][]float64{{0.0, 1.5}, {1.5, 3.0}, {3.0, 4.5}, {4.5, 5.0}}},
+		{"zero step", 10.0, 0.0, 0.0, [][]float64{}},
+		{"negative step", 10.0, -1.0, 0.0, [][]float64{}},
+		{"NaN step", 10.0, math.NaN(), 0.0, [][]float64{}},
+		{"infinite to", math.Inf(1), 1.0, 0.0, [][]float64{}},
+		{"too many pairs", 1.0, 1e-300, 0.0, [][]float64{}},
+		{"infinite step", 10.0, math.Inf(1), 0.0, [][]float64{{0.0, 10.0}}},
 	}
 
 	for _, tt := range tests {
@@ -525,6 +695,65 @@ func intPtr(i int) *int {
 	return &i
 }
 
+func TestFormatShortestDigits(t *testing.T) {
+	for _, value := range []float64{1e23, 1.5e25, 123456789012345680000} {
+		formatted := strings.ReplaceAll(Format(value, nil, nil, nil), ",", "")
+		if spelled := strconv.FormatFloat(value, 'f', -1, 64); formatted != spelled {
+			t.Errorf("Format(%g) has digits %s, want the shortest digits %s", value, formatted, spelled)
+		}
+	}
+}
+
+func TestLocaleCachesShareSpellings(t *testing.T) {
+	for _, locale := range []string{"en_us", "EN-us", "en-us"} {
+		if printer(locale) != printer("en-US") {
+			t.Errorf("printer(%q) is not the printer of en-US", locale)
+		}
+	}
+	if key := localeKey("de_ch"); key != "de-CH" {
+		t.Errorf("localeKey(%q) = %q, want %q", "de_ch", key, "de-CH")
+	}
+
+	count := func() int {
+		n := 0
+		printers.Range(func(_, _ any) bool { n++; return true })
+		return n
+	}
+	printer("fr-CA")
+	before := count()
+	printer("fr_ca")
+	printer("FR-ca")
+	if after := count(); after != before {
+		t.Errorf("printers grew from %d to %d for other spellings of fr-CA", before, after)
+	}
+
+	for _, locale := range []string{"fr-CA-u-ca-buddhist", "fr-CA-x-a", "fr-CA-x-b", "fr-CA-1996-u-co-phonebk"} {
+		printer(locale)
+	}
+	if after := count(); after != before {
+		t.Errorf("printers grew from %d to %d for extensions of fr-CA", before, after)
+	}
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"ar-EG-u-nu-latn-ca-islamic", "ar-EG-u-nu-latn"},
+		{"sr-Latn-RS-x-private", "sr-Latn-RS"},
+		{"", "und"},
+	}
+	for _, tt := range tests {
+		if key := localeKey(tt.locale); key != tt.expected {
+			t.Errorf("localeKey(%q) = %q, want %q", tt.locale, key, tt.expected)
+		}
+	}
+
+	thai := "th-TH-u-ca-buddhist-nu-thai"
+	if result := Format(1234.5, nil, nil, &thai); result != "๑,๒๓๔.๕" {
+		t.Errorf("Format() in %s = %q, want %q", thai, result, "๑,๒๓๔.๕")
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,134 @@
+package number
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/rulzi/helper-go/internal/langtag"
+)
+
+// OrdinalFunc returns the text placed before and after the digits of the
+// ordinal of a non-negative integer given as decimal digits, such as "" and
+// "st" for "1" in English.
+type OrdinalFunc func(digits string) (prefix, suffix string)
+
+// OrdinalSpeller is implemented by a Speller that can also spell out ordinals.
+type OrdinalSpeller interface {
+	Speller
+	// SpellOrdinal spells out the ordinal of a non-negative integer given as
+	// decimal digits.
+	SpellOrdinal(digits string) string
+}
+
+var (
+	// Mutex for thread-safe access to the ordinal registry
+	ordinalsMu sync.RWMutex
+
+	// Ordinal rules by language tag, such as "en" or "fr-CA", looked up with
+	// langtag.Lookup
+	ordinals = map[string]OrdinalFunc{
+		"en": englishOrdinal,
+		"id": indonesianOrdinal,
+		"fr": frenchOrdinal,
+		"de": germanOrdinal,
+	}
+
+	// English ordinal words that are not formed by adding "th"
+	englishOrdinalWords = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// RegisterOrdinal registers the ordinal rule used for the given locale and the
+// locales that inherit from it, so a rule for "nl" also covers "nl-BE".
+func RegisterOrdinal(locale string, ordinal OrdinalFunc) {
+	ordinalsMu.Lock()
+	defer ordinalsMu.Unlock()
+	ordinals[localeTag(locale).String()] = ordinal
+}
+
+// ordinalFor returns the ordinal rule for the locale, falling back to English.
+func ordinalFor(locale string) OrdinalFunc {
+	ordinalsMu.RLock()
+	defer ordinalsMu.RUnlock()
+
+	if ordinal, ok := langtag.Lookup(ordinals, localeTag(locale)); ok {
+		return ordinal
+	}
+	return englishOrdinal
+}
+
+func englishOrdinal(digits string) (string, string) {
+	n := lastDigits(digits, 2)
+	if n >= 11 && n <= 13 {
+		return "", "th"
+	}
+
+	switch n % 10 {
+	case 1:
+		return "", "st"
+	case 2:
+		return "", "nd"
+	case 3:
+		return "", "rd"
+	default:
+		return "", "th"
+	}
+}
+
+func indonesianOrdinal(string) (string, string) {
+	return "ke-", ""
+}
+
+func frenchOrdinal(digits string) (string, string) {
+	if digits == "1" {
+		return "", "er"
+	}
+	return "", "e"
+}
+
+func germanOrdinal(string) (string, string) {
+	return "", "."
+}
+
+// lastDigits returns the value of the last n digits.
+func lastDigits(digits string, n int) int {
+	value := 0
+	for _, d := range digits[max(len(digits)-n, 0):] {
+		value = value*10 + int(d-'0')
+	}
+	return value
+}
+
+func (s englishSpeller) SpellOrdinal(digits string) string {
+	words := s.SpellInteger(digits)
+
+	cut := strings.LastIndexAny(words, " -") + 1
+	head, last := words[:cut], words[cut:]
+	if word, ok := englishOrdinalWords[last]; ok {
+		return head + word
+	}
+	if strings.HasSuffix(last, "y") {
+		return head + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return head + last + "th"
+}
+
+func (s indonesianSpeller) SpellOrdinal(digits string) string {
+	if strings.TrimLeft(digits, "0") == "1" {
+		return "pertama"
+	}
+	words := s.SpellInteger(digits)
+	// "ke" only joins "satu" on its own, so the ordinal of "satu kuadriliun"
+	// is "ke-satu kuadriliun" rather than "kesatu kuadriliun"
+	if strings.HasPrefix(words, indonesianOnes[1]+" ") {
+		return "ke-" + words
+	}
+	return "ke" + words
+}
@@ -0,0 +1,35 @@
+package number
+
+import "testing"
+
+func TestRegisterOrdinal(t *testing.T) {
+	defer func() {
+		ordinalsMu.Lock()
+		delete(ordinals, "nl")
+		ordinalsMu.Unlock()
+	}()
+
+	RegisterOrdinal("nl", func(string) (string, string) {
+		return "", "e"
+	})
+
+	tests := []struct {
+		name     string
+		number   float64
+		locale   string
+		expected string
+	}{
+		{"registered language", 1, "nl", "1e"},
+		{"regional variant", 1000, "nl-BE", "1.000e"},
+		{"other languages unchanged", 1, "fr", "1er"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Ordinal(tt.number, &tt.locale)
+			if result != tt.expected {
+				t.Errorf("Ordinal(%f, %q) = %q, want %q", tt.number, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
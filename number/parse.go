@@ -0,0 +1,454 @@
+package number
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	xnumber "golang.org/x/text/number"
+)
+
+var (
+	// ErrInvalidNumber is returned when the input is not a number in the locale.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrAmbiguousNumber is returned when the separators in the input do not
+	// match the locale, as with "1,5" in English.
+	ErrAmbiguousNumber = errors.New("ambiguous number")
+	// ErrUnknownUnit is returned when the input ends with an unknown unit.
+	ErrUnknownUnit = errors.New("unknown unit")
+	// ErrAmbiguousCurrency is returned when a currency symbol is shared by
+	// several currencies, none of which is the default currency.
+	ErrAmbiguousCurrency = errors.New("ambiguous currency")
+)
+
+// numberSymbols holds the separators and digits a locale formats numbers with.
+type numberSymbols struct {
+	group   string
+	decimal string
+	digits  map[rune]rune
+	native  [10]rune
+	// Whether groups between the first and the last have two digits, as in
+	// "12,34,567" in Indian English
+	pairs bool
+}
+
+// nativeDigit maps an ASCII digit to the digit the locale formats with.
+func (s numberSymbols) nativeDigit(r rune) rune {
+	if r >= '0' && r <= '9' {
+		return s.native[r-'0']
+	}
+	return r
+}
+
+// Symbols by canonical language tag, derived on first use
+var localeSymbols sync.Map
+
+// symbolsFor derives the symbols of a locale by formatting sample numbers.
+func symbolsFor(locale string) numberSymbols {
+	key := localeKey(locale)
+	if sym, ok := localeSymbols.Load(key); ok {
+		return sym.(numberSymbols)
+	}
+
+	p := printer(locale)
+	sym := numberSymbols{digits: make(map[rune]rune, 10)}
+	for i := 0; i < 10; i++ {
+		for _, r := range stripBidi(p.Sprint(xnumber.Decimal(i))) {
+			sym.digits[r] = rune('0' + i)
+			sym.native[i] = r
+		}
+	}
+
+	// Collect the runs of separators in "1,234,567.5" and the number of
+	// digits before each of them
+	var separators []string
+	var lengths []int
+	var run strings.Builder
+	length := 0
+	for _, r := range stripBidi(p.Sprint(xnumber.Decimal(1234567.5, xnumber.MinFractionDigits(1)))) {
+		if _, isDigit := sym.digits[r]; isDigit {
+			if run.Len() > 0 {
+				separators = append(separators, run.String())
+				lengths = append(lengths, length)
+				run.Reset()
+				length = 0
+			}
+			length++
+			continue
+		}
+		run.WriteRune(r)
+	}
+	if len(separators) > 0 {
+		sym.decimal = separators[len(separators)-1]
+	}
+	if len(separators) > 1 {
+		sym.group = separators[0]
+	}
+	sym.pairs = len(lengths) > 2 && lengths[1] == 2
+
+	actual, _ := localeSymbols.LoadOrStore(key, sym)
+	return actual.(numberSymbols)
+}
+
+// stripBidi removes the bidirectional marks some locales put around numbers.
+func stripBidi(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\u200e', '\u200f', '\u061c':
+			return -1
+		}
+		return r
+	}, value)
+}
+
+// Parse parses a number formatted for the given locale, such as "1,234.56" in
+// English or "1.234,56" in German, and is the inverse of Format. Locale digits,
+// a leading sign and the usual space variants used for grouping are accepted.
+// Input whose separators do not fit the locale returns ErrAmbiguousNumber.
+func Parse(value string, locale *string) (float64, error) {
+	loc := localeOrDefault(locale)
+
+	normalized, err := normalizeNumber(value, loc)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(normalized, 64)
+}
+
+// normalizeNumber rewrites a number formatted for the locale into the form
+// accepted by strconv.ParseFloat.
+func normalizeNumber(value string, locale string) (string, error) {
+	sym := symbolsFor(locale)
+
+	s := strings.Map(func(r rune) rune {
+		if d, ok := sym.digits[r]; ok {
+			return d
+		}
+		return r
+	}, stripBidi(value))
+	s = strings.TrimSpace(s)
+
+	sign := ""
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = "-", s[len("-"):]
+	case strings.HasPrefix(s, "−"):
+		sign, s = "-", s[len("−"):]
+	case strings.HasPrefix(s, "+"):
+		s = s[len("+"):]
+	}
+	s = strings.TrimSpace(s)
+
+	integer, fraction, hasFraction := s, "", false
+	if sym.decimal != "" {
+		integer, fraction, hasFraction = strings.Cut(s, sym.decimal)
+	}
+	if integer == "" && fraction == "" {
+		return "", fmt.Errorf("%w %q", ErrInvalidNumber, value)
+	}
+
+	switch {
+	case sym.decimal != "" && strings.Contains(fraction, sym.decimal):
+		return "", fmt.Errorf("%w %q", ErrInvalidNumber, value)
+	case len(splitGroups(fraction, sym.group)) > 1:
+		return "", fmt.Errorf("%w %q for locale %q", ErrAmbiguousNumber, value, locale)
+	case !isDigits(fraction):
+		return "", fmt.Errorf("%w %q", ErrInvalidNumber, value)
+	}
+
+	groups := splitGroups(integer, sym.group)
+	for i, group := range groups {
+		if len(groups) == 1 && group == "" && hasFraction {
+			break // ".5"
+		}
+		if group == "" || !isDigits(group) {
+			return "", fmt.Errorf("%w %q", ErrInvalidNumber, value)
+		}
+		if len(groups) == 1 {
+			break
+		}
+		switch {
+		case i == 0 && len(group) > 3,
+			i > 0 && i < len(groups)-1 && len(group) != 3 && (len(group) != 2 || !sym.pairs),
+			i == len(groups)-1 && len(group) != 3:
+			return "", fmt.Errorf("%w %q for locale %q", ErrAmbiguousNumber, value, locale)
+		}
+	}
+
+	normalized := sign + strings.Join(groups, "")
+	if hasFraction {
+		normalized += "." + fraction
+	}
+	return normalized, nil
+}
+
+// splitGroups splits the integer part of a number on the group separator. For
+// locales that group with a space any kind of space is accepted, and an ASCII
+// apostrophe is accepted for a typographic one.
+func splitGroups(integer string, group string) []string {
+	if group == "" {
+		return []string{integer}
+	}
+
+	isGroup := func(r rune) bool {
+		switch {
+		case strings.ContainsRune(group, r):
+			return true
+		case strings.TrimFunc(group, unicode.IsSpace) == "":
+			return unicode.IsSpace(r)
+		case group == "’":
+			return r == '\''
+		}
+		return false
+	}
+
+	var groups []string
+	start := 0
+	for i, r := range integer {
+		if isGroup(r) {
+			groups = append(groups, integer[start:i])
+			start = i + len(string(r))
+		}
+	}
+	return append(groups, integer[start:])
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	return !strings.ContainsFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+}
+
+// ParseCurrency parses an amount formatted by Currency, such as "$1,234.56",
+// "Rp 1.500.000" or "($5.00)", and returns the amount and the currency code.
+// The currency is taken from a symbol or code before or after the amount, or
+// is the default currency when there is neither. A symbol shared by several
+// currencies, such as "kr", resolves to the default currency when it is one
+// of them and returns ErrAmbiguousCurrency otherwise.
+func ParseCurrency(value string, locale *string) (float64, string, error) {
+	return parseCurrency(value, localeOrDefault(locale), DefaultCurrency())
+}
+
+// parseCurrency parses an amount of money, using fallback as the currency
+// when value has no symbol or code.
+func parseCurrency(value string, loc string, fallback string) (float64, string, error) {
+	s := strings.TrimSpace(stripBidi(value))
+	sign := ""
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		sign, s = "-", strings.TrimSpace(s[1:len(s)-1])
+	}
+	for _, minus := range []string{"-", "−"} {
+		if strings.HasPrefix(s, minus) {
+			sign, s = "-", strings.TrimSpace(s[len(minus):])
+			break
+		}
+	}
+
+	code, rest, err := cutCurrency(s, loc, fallback)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w in %q", err, value)
+	}
+
+	amount, err := Parse(sign+rest, &loc)
+	if err != nil {
+		return 0, "", err
+	}
+	return amount, code, nil
+}
+
+// cutCurrency removes a currency symbol or code from either end of s.
+func cutCurrency(s string, locale string, fallback string) (string, string, error) {
+	// Only the longest text that matches counts, so "A$" wins over "$"
+	var matched, rest string
+	var standard, narrow []string
+	for _, c := range currencyCandidates(locale) {
+		if matched != "" && c.text != matched {
+			continue
+		}
+		if r, ok := cutAffix(s, c.text); ok {
+			matched, rest = c.text, r
+			if c.standard {
+				standard = appendUnique(standard, c.code)
+			} else {
+				narrow = appendUnique(narrow, c.code)
+			}
+		}
+	}
+
+	// Standard symbols win over narrow ones
+	matches := standard
+	if len(matches) == 0 {
+		matches = narrow
+	}
+
+	switch {
+	case len(matches) == 1:
+		return matches[0], rest, nil
+	case len(matches) > 1:
+		for _, code := range matches {
+			if code == fallback {
+				return code, rest, nil
+			}
+		}
+		return "", "", fmt.Errorf("%w %s", ErrAmbiguousCurrency, strings.Join(matches, "/"))
+	}
+
+	// An unknown three-letter code, as written by Currency for unknown currencies
+	if len(s) > 3 && isCurrencyCode(s[:3]) {
+		return s[:3], strings.TrimSpace(s[3:]), nil
+	}
+	if len(s) > 3 && isCurrencyCode(s[len(s)-3:]) {
+		return s[len(s)-3:], strings.TrimSpace(s[:len(s)-3]), nil
+	}
+	return fallback, s, nil
+}
+
+// appendUnique appends value to values unless it is already present.
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// cutAffix removes affix from the start or the end of s.
+func cutAffix(s, affix string) (string, bool) {
+	if rest, ok := strings.CutPrefix(s, affix); ok {
+		return strings.TrimSpace(rest), true
+	}
+	if rest, ok := strings.CutSuffix(s, affix); ok {
+		return strings.TrimSpace(rest), true
+	}
+	return s, false
+}
+
+// isCurrencyCode reports whether s looks like an ISO 4217 code.
+func isCurrencyCode(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return len(s) == 3
+}
+
+// ParsePercentage parses a percentage such as "0.50%" or "12,5 %" and is the
+// inverse of Percentage, so it returns the number Percentage was given, 50 or
+// 1250, which is the number shown times 100. The percent sign is optional.
+func ParsePercentage(value string, locale *string) (float64, error) {
+	loc := localeOrDefault(locale)
+
+	s := strings.TrimSpace(stripBidi(value))
+	if rest, ok := cutAffix(s, "%"); ok {
+		s = rest
+	} else if rest, ok := cutAffix(s, "٪"); ok {
+		s = rest
+	}
+
+	normalized, err := normalizeNumber(s, loc)
+	if err != nil {
+		return 0, err
+	}
+	// Shifting the exponent keeps "0.07" exact, unlike multiplying by 100
+	return strconv.ParseFloat(normalized+"e2", 64)
+}
+
+// ParseForHumans parses a number formatted by ForHumans or Abbreviate, such as
+// "1.5K" or "1.5 thousand", and returns 1500. Units of the locale, such as
+// "juta" in Indonesian or "万" in Japanese, are tried before the English ones.
+// Units are matched without regard to case, and stacked units such as "1KQ"
+// multiply.
+func ParseForHumans(value string, locale *string) (float64, error) {
+	loc := localeOrDefault(locale)
+
+	s := strings.TrimSpace(value)
+	exponent := 0
+	for {
+		exp, rest, ok := cutHumanUnit(s, loc)
+		if !ok {
+			break
+		}
+		exponent += exp
+		s = rest
+	}
+
+	normalized, err := normalizeNumber(s, loc)
+	if err != nil {
+		if strings.ContainsFunc(s, unicode.IsLetter) {
+			return 0, fmt.Errorf("%w in %q", ErrUnknownUnit, value)
+		}
+		return 0, err
+	}
+	return strconv.ParseFloat(normalized+"e"+strconv.Itoa(exponent), 64)
+}
+
+// cutHumanUnit removes the longest abbreviated or full unit of the locale from
+// the end of s, falling back to the English units.
+func cutHumanUnit(s string, locale string) (int, string, bool) {
+	lower := strings.ToLower(s)
+	for _, units := range []HumanUnits{humanUnitsFor(locale), humanUnitsFor("en")} {
+		best, exponent := "", 0
+		for _, unit := range slices.Concat(units.Abbreviated, units.Full) {
+			for _, text := range []string{unit.One, unit.Other} {
+				text = strings.ToLower(strings.TrimSpace(text))
+				if len(text) > len(best) && strings.HasSuffix(lower, text) {
+					best, exponent = text, unit.Exponent
+				}
+			}
+		}
+		if best != "" {
+			return exponent, strings.TrimSpace(s[:len(s)-len(best)]), true
+		}
+	}
+	return 0, s, false
+}
+
+// ParseFileSize parses a size formatted by FileSize, such as "2.3 GB" or
+// "1.5 GiB", and returns the number of bytes. Units are matched without
+// regard to case, IEC units such as KiB are powers of 1024, as are KB and the
+// like, and a missing unit means bytes.
+func ParseFileSize(value string, locale *string) (float64, error) {
+	return parseFileSize(value, SizeJEDEC, localeOrDefault(locale))
+}
+
+// ParseFileSizeWith parses a size like ParseFileSize, reading units such as KB
+// and MB as powers of 1000 when the standard is SizeSI.
+func ParseFileSizeWith(value string, standard SizeStandard, locale *string) (float64, error) {
+	return parseFileSize(value, standard, localeOrDefault(locale))
+}
+
+// parseFileSize parses a size, reading units without an "i" in the base of standard.
+func parseFileSize(value string, standard SizeStandard, loc string) (float64, error) {
+	s := strings.TrimSpace(value)
+	upper := strings.ToUpper(s)
+
+	base := 1024.0
+	if standard == SizeSI {
+		base = 1000
+	}
+
+	power := 0
+	for i := len(fileSizeUnits) - 1; i >= 0; i-- {
+		if strings.HasSuffix(upper, strings.ToUpper(iecSizeUnits[i])) {
+			power, base, s = i, 1024, strings.TrimSpace(s[:len(s)-len(iecSizeUnits[i])])
+			break
+		}
+		if strings.HasSuffix(upper, fileSizeUnits[i]) {
+			power, s = i, strings.TrimSpace(s[:len(s)-len(fileSizeUnits[i])])
+			break
+		}
+	}
+
+	size, err := Parse(s, &loc)
+	if err != nil {
+		if strings.ContainsFunc(s, unicode.IsLetter) {
+			return 0, fmt.Errorf("%w in %q", ErrUnknownUnit, value)
+		}
+		return 0, err
+	}
+	return size * math.Pow(base, float64(power)), nil
+}
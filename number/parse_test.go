@@ -0,0 +1,278 @@
+package number
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		locale   *string
+		expected float64
+		err      error
+	}{
+		{"plain", "123.456", nil, 123.456, nil},
+		{"grouped", "1,234,567.89", nil, 1234567.89, nil},
+		{"negative", "-1,234.5", nil, -1234.5, nil},
+		{"plus sign", "+42", nil, 42, nil},
+		{"leading decimal", ".5", nil, 0.5, nil},
+		{"surrounding space", "  12  ", nil, 12, nil},
+		{"indonesian", "1.234,56", stringPtr("id"), 1234.56, nil},
+		{"indonesian thousands", "1.500.000", stringPtr("id_ID"), 1500000, nil},
+		{"french narrow space", "1\u202f234,5", stringPtr("fr"), 1234.5, nil},
+		{"french plain space", "1 234,5", stringPtr("fr"), 1234.5, nil},
+		{"swiss apostrophe", "1'234.5", stringPtr("de-CH"), 1234.5, nil},
+		{"indian grouping", "12,34,567", stringPtr("en-IN"), 1234567, nil},
+		{"lakh grouping", "1,00,00,000", stringPtr("hi"), 10000000, nil},
+		{"persian digits", "\u200e\u2212۱٬۲۳۴٫۵", stringPtr("fa"), -1234.5, nil},
+		{"empty", "", nil, 0, ErrInvalidNumber},
+		{"letters", "abc", nil, 0, ErrInvalidNumber},
+		{"two decimal points", "1.2.3", nil, 0, ErrInvalidNumber},
+		{"empty group", "1,,234", nil, 0, ErrInvalidNumber},
+		{"decimal comma in english", "1,5", nil, 0, ErrAmbiguousNumber},
+		{"german format in english", "1.234,56", nil, 0, ErrAmbiguousNumber},
+		{"long first group", "1234,567", nil, 0, ErrAmbiguousNumber},
+		{"indian grouping in english", "12,34,567", nil, 0, ErrAmbiguousNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(tt.value, tt.locale)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("Parse(%q) error = %v, want %v", tt.value, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Parse(%q) = %v, want %v", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		locale   *string
+		expected float64
+		currency string
+		err      error
+	}{
+		{"dollar", "$1,234.56", nil, 1234.56, "USD", nil},
+		{"negative sign first", "-$123.45", nil, -123.45, "USD", nil},
+		{"negative sign after symbol", "$-123.45", nil, -123.45, "USD", nil},
+		{"longest symbol wins", "A$10.00", nil, 10, "AUD", nil},
+		{"rupiah", "Rp 1.500.000", stringPtr("id"), 1500000, "IDR", nil},
+		{"code suffix", "123,45 EUR", stringPtr("de"), 123.45, "EUR", nil},
+		{"unknown code", "XYZ 123.45", nil, 123.45, "XYZ", nil},
+		{"no symbol uses default", "99.5", nil, 99.5, "USD", nil},
+		{"standard symbol wins over narrow", "¥100", nil, 100, "JPY", nil},
+		{"symbol after amount", "-5,00\u00a0€", stringPtr("de"), -5, "EUR", nil},
+		{"sign after symbol", "€ -5,00", stringPtr("nl"), -5, "EUR", nil},
+		{"parentheses", "($5.00)", nil, -5, "USD", nil},
+		{"locale symbol", "US$ 1.000", stringPtr("id"), 1000, "USD", nil},
+		{"shared narrow symbol", "kr 100", nil, 0, "", ErrAmbiguousCurrency},
+		{"invalid amount", "$abc", nil, 0, "", ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, currency, err := ParseCurrency(tt.value, tt.locale)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("ParseCurrency(%q) error = %v, want %v", tt.value, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCurrency(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected || currency != tt.currency {
+				t.Errorf("ParseCurrency(%q) = %v, %q, want %v, %q", tt.value, result, currency, tt.expected, tt.currency)
+			}
+		})
+	}
+
+	t.Run("shared symbol uses default currency", func(t *testing.T) {
+		WithCurrency("SEK", func() {
+			result, currency, err := ParseCurrency("kr 100", nil)
+			if err != nil || result != 100 || currency != "SEK" {
+				t.Errorf("ParseCurrency(%q) = %v, %q, %v, want 100, %q", "kr 100", result, currency, err, "SEK")
+			}
+		})
+	})
+}
+
+func TestParsePercentage(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		locale   *string
+		expected float64
+	}{
+		{"basic", "50%", nil, 5000},
+		{"fraction", "0.50%", nil, 50},
+		{"small fraction", "0.07%", nil, 7},
+		{"indonesian", "12,5%", stringPtr("id"), 1250},
+		{"german space", "12,5\u00a0%", stringPtr("de"), 1250},
+		{"turkish prefix", "%50", stringPtr("tr"), 5000},
+		{"without sign", "7", nil, 700},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParsePercentage(tt.value, tt.locale)
+			if err != nil {
+				t.Fatalf("ParsePercentage(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParsePercentage(%q) = %v, want %v", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseForHumans(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		locale   *string
+		expected float64
+		err      error
+	}{
+		{"abbreviated", "1.5K", nil, 1500, nil},
+		{"lowercase", "2.3m", nil, 2300000, nil},
+		{"full unit", "1.5 thousand", nil, 1500, nil},
+		{"billion", "7 billion", nil, 7e9, nil},
+		{"stacked units", "1KQ", nil, 1e18, nil},
+		{"negative", "-1K", nil, -1000, nil},
+		{"no unit", "500", nil, 500, nil},
+		{"indonesian decimals", "1,5K", stringPtr("id"), 1500, nil},
+		{"indonesian unit", "1,5 juta", stringPtr("id"), 1500000, nil},
+		{"indonesian abbreviation", "2 rb", stringPtr("id"), 2000, nil},
+		{"indonesian miliar abbreviation", "3 M", stringPtr("id"), 3e9, nil},
+		{"japanese man", "1.5万", stringPtr("ja"), 15000, nil},
+		{"lakh crore", "1 lakh crore", stringPtr("en-IN"), 1e12, nil},
+		{"french plural", "2 millions", stringPtr("fr"), 2e6, nil},
+		{"unknown unit", "1.5X", nil, 0, ErrUnknownUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseForHumans(tt.value, tt.locale)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("ParseForHumans(%q) error = %v, want %v", tt.value, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseForHumans(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseForHumans(%q) = %v, want %v", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFileSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		locale   *string
+		expected float64
+		err      error
+	}{
+		{"bytes", "500 B", nil, 500, nil},
+		{"kilobytes", "1.5 KB", nil, 1536, nil},
+		{"gigabytes", "2 GB", nil, 2 * 1024 * 1024 * 1024, nil},
+		{"lowercase", "1mb", nil, 1024 * 1024, nil},
+		{"no unit", "42", nil, 42, nil},
+		{"indonesian decimals", "1,5 KB", stringPtr("id"), 1536, nil},
+		{"unknown unit", "3 XB", nil, 0, ErrUnknownUnit},
+		{"iec units", "1.5 GiB", nil, 1.5 * 1024 * 1024 * 1024, nil},
+		{"lowercase iec", "2kib", nil, 2048, nil},
+		{"negative", "-1 KB", nil, -1024, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseFileSize(tt.value, tt.locale)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("ParseFileSize(%q) error = %v, want %v", tt.value, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFileSize(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseFileSize(%q) = %v, want %v", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFileSizeWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		standard SizeStandard
+		expected float64
+	}{
+		{"si kilobytes", "1.5 kB", SizeSI, 1500},
+		{"si gigabytes", "2 GB", SizeSI, 2e9},
+		{"iec units under si", "1 KiB", SizeSI, 1024},
+		{"jedec kilobytes", "1 KB", SizeJEDEC, 1024},
+		{"iec kilobytes", "1 KB", SizeIEC, 1024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseFileSizeWith(tt.value, tt.standard, nil)
+			if err != nil {
+				t.Fatalf("ParseFileSizeWith(%q) error = %v", tt.value, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseFileSizeWith(%q) = %v, want %v", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, locale := range []string{"en", "id", "de", "fr", "de-CH", "en-IN", "fa", "ar"} {
+		loc := locale
+		t.Run(locale, func(t *testing.T) {
+			for _, value := range []float64{0, 1234567.25, -98765.5, 0.125} {
+				if result, err := Parse(Format(value, nil, nil, &loc), &loc); err != nil || result != value {
+					t.Errorf("Parse(Format(%v)) = %v, %v", value, result, err)
+				}
+				if result, _, err := ParseCurrency(Currency(value, "EUR", &loc, intPtr(3)), &loc); err != nil || result != value {
+					t.Errorf("ParseCurrency(Currency(%v)) = %v, %v", value, result, err)
+				}
+				if result, err := ParsePercentage(Percentage(value, 0, intPtr(6), &loc), &loc); err != nil || result != value {
+					t.Errorf("ParsePercentage(Percentage(%v)) = %v, %v", value, result, err)
+				}
+			}
+		})
+	}
+
+	WithLocale("de", func() {
+		if result, err := ParseForHumans(ForHumans(1500000, 1, nil, false), nil); err != nil || result != 1500000 {
+			t.Errorf("ParseForHumans(ForHumans(1500000)) = %v, %v", result, err)
+		}
+		if result, err := ParseFileSize(FileSize(1536, 1, nil), nil); err != nil || result != 1536 {
+			t.Errorf("ParseFileSize(FileSize(1536)) = %v, %v", result, err)
+		}
+	})
+}
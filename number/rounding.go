@@ -0,0 +1,166 @@
+package number
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// RoundingMode controls how numbers are rounded to the requested precision.
+// The zero value is RoundNearest, which every function of the package uses
+// unless a Formatter is given another mode with WithRoundingMode.
+type RoundingMode int
+
+const (
+	// RoundNearest rounds the exact value of the float64 to the nearest
+	// neighbour, as strconv.FormatFloat does, so 2.675 becomes 2.67 because
+	// the nearest float64 is slightly below 2.675, and 0.005 becomes 0.01
+	// because it is slightly above. Exact ties, as for 2.5, go to the even
+	// neighbour.
+	RoundNearest RoundingMode = iota
+	// RoundHalfEven rounds the shortest decimal representation of the value
+	// to the nearest neighbour and ties to the even one, so 2.5 becomes 2,
+	// 3.5 becomes 4 and 0.005 becomes 0. Also known as banker's rounding.
+	RoundHalfEven
+	// RoundHalfUp rounds to the nearest neighbour and ties away from zero, so
+	// 2.5 becomes 3 and -2.5 becomes -3.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbour and ties toward zero, so
+	// 2.5 becomes 2 and -2.5 becomes -2.
+	RoundHalfDown
+	// RoundUp rounds away from zero, so 1.1 becomes 2 and -1.1 becomes -2.
+	RoundUp
+	// RoundDown rounds toward zero, so 1.9 becomes 1 and -1.9 becomes -1.
+	RoundDown
+	// RoundCeiling rounds toward positive infinity, so 1.1 becomes 2 and -1.9
+	// becomes -1.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity, so 1.9 becomes 1 and -1.1
+	// becomes -2.
+	RoundFloor
+)
+
+// String returns the name of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundNearest:
+		return "Nearest"
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	default:
+		return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// Round rounds value to the given number of fraction digits, or to tens,
+// hundreds and so on for a negative precision. Except for RoundNearest, the
+// value is taken as its shortest decimal representation, so 2.675 rounds half
+// up to 2.68 even though the nearest float64 is slightly below 2.675. NaN and
+// infinities are returned unchanged.
+func (m RoundingMode) Round(value float64, precision int) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	var r *big.Rat
+	if m == RoundNearest {
+		r = new(big.Rat).SetFloat64(value)
+	} else {
+		r = decimalRat(value)
+	}
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(precision))), nil))
+	if precision >= 0 {
+		r.Mul(r, scale)
+	} else {
+		r.Quo(r, scale)
+	}
+
+	rounded := new(big.Rat).SetInt(m.roundRat(r))
+	if precision >= 0 {
+		rounded.Quo(rounded, scale)
+	} else {
+		rounded.Mul(rounded, scale)
+	}
+	result, _ := rounded.Float64()
+	return result
+}
+
+// decimalRat returns the shortest decimal representation of a finite value,
+// so 1.015 is exactly 1.015 rather than the float64 slightly below it.
+func decimalRat(value float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'g', -1, 64))
+	return r
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// roundRat rounds r to an integer.
+func (m RoundingMode) roundRat(r *big.Rat) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	var away bool
+	switch m {
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = r.Sign() > 0
+	case RoundFloor:
+		away = r.Sign() < 0
+	default:
+		// Compare twice the remainder with the denominator
+		cmp := rem.Abs(rem).Lsh(rem, 1).Cmp(r.Denom())
+		switch m {
+		case RoundHalfUp:
+			away = cmp >= 0
+		case RoundHalfDown:
+			away = cmp > 0
+		default:
+			away = cmp > 0 || (cmp == 0 && quo.Bit(0) == 1)
+		}
+	}
+
+	if away {
+		if r.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo
+}
+
+// mirror returns the mode that rounds the negation of a number the same way,
+// for code that formats the absolute value and adds the sign afterwards.
+func (m RoundingMode) mirror() RoundingMode {
+	switch m {
+	case RoundCeiling:
+		return RoundFloor
+	case RoundFloor:
+		return RoundCeiling
+	default:
+		return m
+	}
+}
@@ -0,0 +1,70 @@
+package number
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundingModeRound(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		expected  map[RoundingMode]float64
+	}{
+		{2.5, 0, map[RoundingMode]float64{
+			RoundNearest: 2, RoundHalfEven: 2, RoundHalfUp: 3, RoundHalfDown: 2,
+			RoundUp: 3, RoundDown: 2, RoundCeiling: 3, RoundFloor: 2,
+		}},
+		{-2.5, 0, map[RoundingMode]float64{
+			RoundHalfEven: -2, RoundHalfUp: -3, RoundHalfDown: -2,
+			RoundUp: -3, RoundDown: -2, RoundCeiling: -2, RoundFloor: -3,
+		}},
+		{3.5, 0, map[RoundingMode]float64{
+			RoundHalfEven: 4, RoundHalfUp: 4, RoundHalfDown: 3,
+		}},
+		{1.99, 1, map[RoundingMode]float64{
+			RoundHalfEven: 2, RoundDown: 1.9, RoundFloor: 1.9, RoundCeiling: 2,
+		}},
+		{2.675, 2, map[RoundingMode]float64{
+			RoundNearest: 2.67, RoundHalfEven: 2.68, RoundHalfUp: 2.68, RoundHalfDown: 2.67,
+		}},
+		{1.25, 1, map[RoundingMode]float64{
+			RoundHalfEven: 1.2, RoundHalfUp: 1.3,
+		}},
+		{1.2, 3, map[RoundingMode]float64{
+			RoundUp: 1.2, RoundDown: 1.2,
+		}},
+		{0.005, 2, map[RoundingMode]float64{
+			RoundNearest: 0.01, RoundHalfEven: 0, RoundHalfUp: 0.01,
+		}},
+		{1250, -2, map[RoundingMode]float64{
+			RoundNearest: 1200, RoundHalfEven: 1200, RoundHalfUp: 1300, RoundFloor: 1200, RoundCeiling: 1300,
+		}},
+	}
+
+	for _, tt := range tests {
+		for mode, expected := range tt.expected {
+			t.Run(mode.String(), func(t *testing.T) {
+				if result := mode.Round(tt.value, tt.precision); result != expected {
+					t.Errorf("%s.Round(%v, %d) = %v, want %v", mode, tt.value, tt.precision, result, expected)
+				}
+			})
+		}
+	}
+
+	if result := RoundHalfUp.Round(math.Inf(1), 2); !math.IsInf(result, 1) {
+		t.Errorf("Round(+Inf) = %v, want +Inf", result)
+	}
+	if result := RoundHalfUp.Round(math.NaN(), 2); !math.IsNaN(result) {
+		t.Errorf("Round(NaN) = %v, want NaN", result)
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if result := RoundCeiling.String(); result != "Ceiling" {
+		t.Errorf("String() = %q, want %q", result, "Ceiling")
+	}
+	if result := RoundingMode(42).String(); result != "RoundingMode(42)" {
+		t.Errorf("String() = %q, want %q", result, "RoundingMode(42)")
+	}
+}
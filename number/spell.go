@@ -0,0 +1,206 @@
+package number
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rulzi/helper-go/internal/langtag"
+)
+
+// Speller spells out numbers in words for one language.
+type Speller interface {
+	// SpellInteger spells out a non-negative integer given as decimal digits.
+	SpellInteger(digits string) string
+	// Minus returns the word placed in front of negative numbers.
+	Minus() string
+	// Point returns the word placed between the integer and the fraction digits.
+	Point() string
+}
+
+var (
+	// Mutex for thread-safe access to the speller registry
+	spellersMu sync.RWMutex
+
+	// Spellers by language tag, such as "en" or "pt-BR"
+	spellers = map[string]Speller{
+		"en": englishSpeller{},
+		"id": indonesianSpeller{},
+	}
+
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	englishTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+
+	indonesianOnes = []string{
+		"nol", "satu", "dua", "tiga", "empat", "lima", "enam", "tujuh", "delapan", "sembilan",
+	}
+	indonesianScales = []string{
+		"", "ribu", "juta", "miliar", "triliun", "kuadriliun", "kuintiliun",
+		"sekstiliun", "septiliun", "oktiliun", "noniliun", "desiliun",
+	}
+)
+
+// RegisterSpeller registers the speller used for the given locale. A speller
+// registered for a language such as "pt" is also used for its regional
+// variants unless one of them has a speller of its own.
+func RegisterSpeller(locale string, speller Speller) {
+	spellersMu.Lock()
+	defer spellersMu.Unlock()
+	spellers[localeTag(locale).String()] = speller
+}
+
+// spellerFor returns the speller for the locale, falling back to its CLDR
+// parents and base language. An empty or undetermined locale has no speller.
+func spellerFor(locale string) (Speller, bool) {
+	spellersMu.RLock()
+	defer spellersMu.RUnlock()
+
+	return langtag.Lookup(spellers, localeTag(locale))
+}
+
+// spellNumber spells out number, reading any fraction digit by digit.
+func spellNumber(speller Speller, number float64) string {
+	digits := strconv.FormatFloat(math.Abs(number), 'f', -1, 64)
+	integer, fraction, _ := strings.Cut(digits, ".")
+
+	words := []string{speller.SpellInteger(integer)}
+	if number < 0 {
+		words = append([]string{speller.Minus()}, words...)
+	}
+	if fraction != "" {
+		words = append(words, speller.Point())
+		for _, digit := range fraction {
+			words = append(words, speller.SpellInteger(string(digit)))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// spellScaled splits digits into groups of three, spells each non-zero group
+// with spellGroup and joins the results. Numbers beyond the largest scale
+// repeat it, as in "one thousand decillion". Zero gives an empty string.
+func spellScaled(digits string, scales []string, spellGroup func(n, scale int) string) string {
+	digits = strings.TrimLeft(digits, "0")
+
+	top := len(scales) - 1
+	if len(digits) > 3*(top+1) {
+		split := len(digits) - 3*top
+		words := spellScaled(digits[:split], scales, spellGroup) + " " + scales[top]
+		if rest := spellScaled(digits[split:], scales, spellGroup); rest != "" {
+			words += " " + rest
+		}
+		return words
+	}
+
+	var parts []string
+	for scale := (len(digits)+2)/3 - 1; scale >= 0; scale-- {
+		end := len(digits) - 3*scale
+		n, _ := strconv.Atoi(digits[max(end-3, 0):end])
+		if n != 0 {
+			parts = append(parts, spellGroup(n, scale))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// englishSpeller spells numbers in English using the short scale.
+type englishSpeller struct{}
+
+func (englishSpeller) SpellInteger(digits string) string {
+	words := spellScaled(digits, englishScales, func(n, scale int) string {
+		if scale == 0 {
+			return englishHundreds(n)
+		}
+		return englishHundreds(n) + " " + englishScales[scale]
+	})
+	if words == "" {
+		return englishOnes[0]
+	}
+	return words
+}
+
+func (englishSpeller) Minus() string { return "minus" }
+
+func (englishSpeller) Point() string { return "point" }
+
+// englishHundreds spells a number between 1 and 999.
+func englishHundreds(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, englishOnes[n/100]+" hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		parts = append(parts, englishOnes[n])
+	case n%10 == 0:
+		parts = append(parts, englishTens[n/10])
+	default:
+		parts = append(parts, englishTens[n/10]+"-"+englishOnes[n%10])
+	}
+	return strings.Join(parts, " ")
+}
+
+// indonesianSpeller spells numbers in Indonesian.
+type indonesianSpeller struct{}
+
+func (indonesianSpeller) SpellInteger(digits string) string {
+	words := spellScaled(digits, indonesianScales, func(n, scale int) string {
+		switch {
+		case scale == 0:
+			return indonesianHundreds(n)
+		case scale == 1 && n == 1:
+			return "seribu"
+		default:
+			return indonesianHundreds(n) + " " + indonesianScales[scale]
+		}
+	})
+	if words == "" {
+		return indonesianOnes[0]
+	}
+	return words
+}
+
+func (indonesianSpeller) Minus() string { return "minus" }
+
+func (indonesianSpeller) Point() string { return "koma" }
+
+// indonesianHundreds spells a number between 1 and 999.
+func indonesianHundreds(n int) string {
+	var parts []string
+	switch h := n / 100; {
+	case h == 1:
+		parts = append(parts, "seratus")
+	case h > 1:
+		parts = append(parts, indonesianOnes[h]+" ratus")
+	}
+	n %= 100
+	switch {
+	case n == 0:
+	case n < 10:
+		parts = append(parts, indonesianOnes[n])
+	case n == 10:
+		parts = append(parts, "sepuluh")
+	case n == 11:
+		parts = append(parts, "sebelas")
+	case n < 20:
+		parts = append(parts, indonesianOnes[n-10]+" belas")
+	case n%10 == 0:
+		parts = append(parts, indonesianOnes[n/10]+" puluh")
+	default:
+		parts = append(parts, indonesianOnes[n/10]+" puluh "+indonesianOnes[n%10])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,41 @@
+package number
+
+import (
+	"strings"
+	"testing"
+)
+
+type shoutingSpeller struct{ englishSpeller }
+
+func (s shoutingSpeller) SpellInteger(digits string) string {
+	return strings.ToUpper(s.englishSpeller.SpellInteger(digits))
+}
+
+func TestRegisterSpeller(t *testing.T) {
+	defer func() {
+		spellersMu.Lock()
+		delete(spellers, "en-AU")
+		spellersMu.Unlock()
+	}()
+
+	RegisterSpeller("en_AU", shoutingSpeller{})
+
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{"registered variant", "en-AU", "FORTY-TWO"},
+		{"underscore variant", "en_AU", "FORTY-TWO"},
+		{"other variant keeps base", "en-US", "forty-two"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Spell(42, &tt.locale, nil, nil)
+			if result != tt.expected {
+				t.Errorf("Spell(42, %q) = %q, want %q", tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
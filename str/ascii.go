@@ -0,0 +1,114 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// Transliterations of lowercase letters and symbols that do not decompose
+	// into ASCII, used for every language
+	asciiRules = map[rune]string{
+		// Latin
+		'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th",
+		'ł': "l", 'ı': "i", 'ħ': "h", 'ŧ': "t", 'ŋ': "ng", 'ĸ': "k", 'ſ': "s",
+		// Cyrillic, as in Russian
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+		'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+		'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+		'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+		'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+		'ґ': "g", 'є': "ye", 'і': "i", 'ї': "yi", 'ђ': "dj", 'ј': "j", 'љ': "lj",
+		'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+		// Greek
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+		'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+		'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+		'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+		// Punctuation
+		'‘': "'", '’': "'", '‚': "'", '‛': "'", '“': `"`, '”': `"`, '„': `"`,
+		'«': `"`, '»': `"`, '‹': "'", '›': "'", '–': "-", '—': "-", '−': "-",
+		'•': "*", '×': "x", '÷': "/",
+	}
+
+	// Transliterations that differ from asciiRules, by language
+	asciiLanguageRules = map[string]map[rune]string{
+		"de": {'ä': "ae", 'ö': "oe", 'ü': "ue"},
+		"da": {'å': "aa", 'æ': "ae", 'ø': "oe"},
+		"nb": {'å': "aa", 'æ': "ae", 'ø': "oe"},
+		"nn": {'å': "aa", 'æ': "ae", 'ø': "oe"},
+		"no": {'å': "aa", 'æ': "ae", 'ø': "oe"},
+		"bg": {'х': "h", 'щ': "sht", 'ъ': "a", 'ь': "y", 'ю': "yu", 'я': "ya"},
+		"uk": {'г': "h", 'и': "y", 'х': "kh", 'щ': "shch", 'ь': ""},
+		"sr": {'đ': "dj", 'х': "h", 'ц': "c", 'ч': "c", 'ш': "s", 'ж': "z"},
+		"mk": {'х': "h", 'ц': "c"},
+	}
+)
+
+// Ascii transliterates a UTF-8 value to ASCII using the rules of the given
+// language, such as "de" for ä → ae or "bg" for щ → sht. Accents are removed
+// from letters that have no rule of their own, so Vietnamese "Tiếng Việt"
+// becomes "Tieng Viet". Characters that cannot be transliterated, such as
+// Chinese characters, are removed. An empty language uses the general rules.
+func Ascii(value, language string) string {
+	if IsAscii(value) {
+		return value
+	}
+
+	rules := asciiLanguageRules[asciiLanguage(language)]
+
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, r := range norm.NFC.String(value) {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		if replacement, ok := transliterate(r, rules); ok {
+			b.WriteString(replacement)
+			continue
+		}
+		// Decompose into base letters and marks, keeping what is left of the
+		// letter once the marks are dropped
+		for _, d := range norm.NFKD.String(string(r)) {
+			switch {
+			case d < utf8.RuneSelf:
+				b.WriteRune(d)
+			case unicode.Is(unicode.Mn, d):
+			default:
+				if replacement, ok := transliterate(d, rules); ok {
+					b.WriteString(replacement)
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// asciiLanguage returns the base language of a language tag such as "de-AT".
+func asciiLanguage(tag string) string {
+	if tag == "" {
+		return ""
+	}
+	base, _ := language.Make(tag).Base()
+	return base.String()
+}
+
+// transliterate returns the ASCII text for r from the language rules or the
+// general rules. An uppercase letter takes the rule of its lowercase form with
+// the first letter capitalised, so Щ becomes "Shch".
+func transliterate(r rune, rules map[rune]string) (string, bool) {
+	lower := unicode.ToLower(r)
+	replacement, ok := rules[lower]
+	if !ok {
+		replacement, ok = asciiRules[lower]
+	}
+	if !ok || lower == r {
+		return replacement, ok
+	}
+	return Ucfirst(replacement), true
+}
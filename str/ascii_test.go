@@ -0,0 +1,49 @@
+package str
+
+import "testing"
+
+func TestAscii(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		language string
+		expected string
+	}{
+		{"ascii", "Hello World", "", "Hello World"},
+		{"accents", "Café Déjà Vu", "", "Cafe Deja Vu"},
+		{"decomposed accents", "Cafe\u0301", "", "Cafe"},
+		{"general ligatures", "Straße Æsir Œuvre", "", "Strasse Aesir Oeuvre"},
+		{"german umlauts", "Ärger über Öl", "de", "Aerger ueber Oel"},
+		{"german region", "Müller", "de-AT", "Mueller"},
+		{"german locale with underscore", "Müller", "de_DE", "Mueller"},
+		{"umlauts without language", "Müller", "", "Muller"},
+		{"danish", "Søren Ærø Ålborg", "da", "Soeren Aeroe Aalborg"},
+		{"norwegian", "blåbær", "nb", "blaabaer"},
+		{"swedish", "Malmö Åre", "sv", "Malmo Are"},
+		{"russian", "Щука и ёж", "ru", "Shchuka i yozh"},
+		{"bulgarian", "Щастие и хляб", "bg", "Shtastie i hlyab"},
+		{"ukrainian", "Київ і Харків", "uk", "Kyyiv i Kharkiv"},
+		{"serbian", "Ђорђе и Đorđe", "sr", "Djordje i Djordje"},
+		{"greek", "Αθήνα", "el", "Athina"},
+		{"vietnamese", "Tiếng Việt đẹp", "vi", "Tieng Viet dep"},
+		{"turkish", "Işık ğüşç", "tr", "Isik gusc"},
+		{"polish", "Łódź", "pl", "Lodz"},
+		{"punctuation", "“Quoted” – it’s…", "", `"Quoted" - it's...`},
+		{"compatibility forms", "ﬁve ｆｕｌｌ", "", "five full"},
+		{"untransliterable", "漢字 kanji", "", " kanji"},
+		{"unknown language", "Müller", "xx", "Muller"},
+		{"empty", "", "de", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Ascii(tt.value, tt.language)
+			if result != tt.expected {
+				t.Errorf("Ascii(%q, %q) = %q, want %q", tt.value, tt.language, result, tt.expected)
+			}
+			if !IsAscii(result) {
+				t.Errorf("Ascii(%q, %q) = %q is not ASCII", tt.value, tt.language, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package str
+
+import (
+	"container/list"
+	"sync"
+)
+
+// EvictionPolicy selects which entry a full casing cache removes.
+type EvictionPolicy int
+
+const (
+	// EvictLRU removes the least recently used entry.
+	EvictLRU EvictionPolicy = iota
+	// EvictFIFO removes the oldest entry, however often it is used.
+	EvictFIFO
+)
+
+// CacheOptions configures the casing caches used by Snake, Camel and Studly.
+type CacheOptions struct {
+	// Size is the most entries each cache holds; zero or less turns caching off
+	Size int
+	// Policy chooses the entry removed when a cache is full
+	Policy EvictionPolicy
+}
+
+// CacheStats reports the use of the casing caches since the program started.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Entries is the number of strings currently cached
+	Entries int
+}
+
+// DefaultCacheSize is the number of entries each casing cache holds by default.
+const DefaultCacheSize = 1000
+
+// boundedCache maps keys to strings and holds at most the configured number
+// of entries. It is guarded by cacheMu.
+type boundedCache[K comparable] struct {
+	entries map[K]*list.Element
+	// Front is the entry kept longest, back the next one evicted
+	order *list.List
+}
+
+type cacheEntry[K comparable] struct {
+	key   K
+	value string
+}
+
+// snakeKey is the key of the Snake cache, as the result depends on both
+// arguments.
+type snakeKey struct {
+	value, delimiter string
+}
+
+var (
+	// Mutex for thread-safe access to the casing caches and their settings
+	cacheMu sync.Mutex
+
+	cacheOptions = CacheOptions{Size: DefaultCacheSize, Policy: EvictLRU}
+	cacheStats   CacheStats
+
+	// Incremented by every flush, so values computed before it are not stored
+	cacheGeneration uint64
+
+	snakeCache  = newBoundedCache[snakeKey]()
+	camelCache  = newBoundedCache[string]()
+	studlyCache = newBoundedCache[string]()
+)
+
+func newBoundedCache[K comparable]() *boundedCache[K] {
+	return &boundedCache[K]{entries: make(map[K]*list.Element), order: list.New()}
+}
+
+// ConfigureCache sets the size and eviction policy of the casing caches and
+// flushes them. A size of zero or less turns caching off.
+func ConfigureCache(options CacheOptions) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	cacheOptions = options
+	flushCaches()
+}
+
+// CacheStatistics returns the hits, misses and evictions of the casing caches
+// and the number of strings they currently hold.
+func CacheStatistics() CacheStats {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	stats := cacheStats
+	stats.Entries = len(snakeCache.entries) + len(camelCache.entries) + len(studlyCache.entries)
+	return stats
+}
+
+// FlushCache removes all strings from the casing caches.
+func FlushCache() {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	flushCaches()
+}
+
+// flushCaches empties the casing caches. The caller must hold cacheMu.
+func flushCaches() {
+	cacheGeneration++
+	snakeCache.flush()
+	camelCache.flush()
+	studlyCache.flush()
+}
+
+// cached returns the value cached under key in c, computing and storing it
+// with compute on a miss. The lock is not held while compute runs, so it may
+// use other caches, and a value is only stored when no flush happened in the
+// meantime, as it may have been computed with the old settings.
+func cached[K comparable](c *boundedCache[K], key K, compute func() string) string {
+	cacheMu.Lock()
+	if cacheOptions.Size <= 0 {
+		cacheMu.Unlock()
+		return compute()
+	}
+	if value, ok := c.get(key); ok {
+		cacheStats.Hits++
+		cacheMu.Unlock()
+		return value
+	}
+	cacheStats.Misses++
+	generation := cacheGeneration
+	cacheMu.Unlock()
+
+	value := compute()
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if cacheOptions.Size > 0 && cacheGeneration == generation {
+		c.put(key, value)
+	}
+	return value
+}
+
+// flush removes every entry.
+func (c *boundedCache[K]) flush() {
+	clear(c.entries)
+	c.order.Init()
+}
+
+// get returns the value for key, marking it as used under EvictLRU.
+func (c *boundedCache[K]) get(key K) (string, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if cacheOptions.Policy == EvictLRU {
+		c.order.MoveToFront(element)
+	}
+	return element.Value.(*cacheEntry[K]).value, true
+}
+
+// put stores value under key, evicting entries beyond the configured size.
+func (c *boundedCache[K]) put(key K, value string) {
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry[K]).value = value
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry[K]{key: key, value: value})
+
+	for c.order.Len() > cacheOptions.Size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry[K]).key)
+		cacheStats.Evictions++
+	}
+}
@@ -0,0 +1,157 @@
+package str
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// withCacheOptions runs fn with the given cache options and restores the defaults.
+func withCacheOptions(t *testing.T, options CacheOptions, fn func()) {
+	t.Helper()
+	ConfigureCache(options)
+	defer ConfigureCache(CacheOptions{Size: DefaultCacheSize, Policy: EvictLRU})
+	fn()
+}
+
+func TestCacheStatistics(t *testing.T) {
+	withCacheOptions(t, CacheOptions{Size: 10}, func() {
+		before := CacheStatistics()
+		if before.Entries != 0 {
+			t.Fatalf("Entries after ConfigureCache = %d, want 0", before.Entries)
+		}
+
+		Studly("hello world")
+		Studly("hello world")
+		Snake("helloWorld", "_")
+		Snake("helloWorld", "-")
+
+		after := CacheStatistics()
+		if hits := after.Hits - before.Hits; hits != 1 {
+			t.Errorf("Hits = %d, want 1", hits)
+		}
+		if misses := after.Misses - before.Misses; misses != 3 {
+			t.Errorf("Misses = %d, want 3", misses)
+		}
+		if after.Entries != 3 {
+			t.Errorf("Entries = %d, want 3", after.Entries)
+		}
+
+		FlushCache()
+		if entries := CacheStatistics().Entries; entries != 0 {
+			t.Errorf("Entries after FlushCache = %d, want 0", entries)
+		}
+	})
+}
+
+func TestCacheEviction(t *testing.T) {
+	tests := []struct {
+		policy EvictionPolicy
+		// Whether "a" is still cached after "a" is reused and "c" is added
+		keepsReused bool
+	}{
+		{EvictLRU, true},
+		{EvictFIFO, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.policy), func(t *testing.T) {
+			withCacheOptions(t, CacheOptions{Size: 2, Policy: tt.policy}, func() {
+				Studly("a")
+				Studly("b")
+				Studly("a")
+				Studly("c")
+
+				stats := CacheStatistics()
+				if stats.Entries != 2 {
+					t.Errorf("Entries = %d, want 2", stats.Entries)
+				}
+
+				before := CacheStatistics().Hits
+				Studly("a")
+				if kept := CacheStatistics().Hits > before; kept != tt.keepsReused {
+					t.Errorf("reused entry kept = %v, want %v", kept, tt.keepsReused)
+				}
+			})
+		})
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	withCacheOptions(t, CacheOptions{Size: 0}, func() {
+		before := CacheStatistics()
+		if result := Camel("foo_bar"); result != "fooBar" {
+			t.Errorf("Camel() = %q, want %q", result, "fooBar")
+		}
+		Camel("foo_bar")
+
+		after := CacheStatistics()
+		if after.Entries != 0 || after.Hits != before.Hits {
+			t.Errorf("disabled cache stats = %+v", after)
+		}
+	})
+}
+
+func TestCacheSkipsValuesComputedBeforeFlush(t *testing.T) {
+	withCacheOptions(t, CacheOptions{Size: 16}, func() {
+		result := cached(camelCache, "stale key", func() string {
+			// Settings such as the initialisms change while the value is computed
+			FlushCache()
+			return "stale value"
+		})
+		if result != "stale value" {
+			t.Errorf("cached() = %q, want %q", result, "stale value")
+		}
+
+		cacheMu.Lock()
+		_, stored := camelCache.get("stale key")
+		cacheMu.Unlock()
+		if stored {
+			t.Error("cached() stored a value computed before a flush")
+		}
+
+		cached(camelCache, "fresh key", func() string { return "fresh value" })
+		cacheMu.Lock()
+		_, stored = camelCache.get("fresh key")
+		cacheMu.Unlock()
+		if !stored {
+			t.Error("cached() did not store a value computed without a flush")
+		}
+	})
+}
+
+func TestSnakeCacheKeepsArgumentsApart(t *testing.T) {
+	withCacheOptions(t, CacheOptions{Size: 16}, func() {
+		// Joining both arguments with a NUL byte would give both calls one key
+		if result := Snake("a\x00b", "c"); result != "a\x00b" {
+			t.Errorf("Snake(%q, %q) = %q, want %q", "a\x00b", "c", result, "a\x00b")
+		}
+		if result := Snake("a", "b\x00c"); result != "a" {
+			t.Errorf("Snake(%q, %q) = %q, want %q", "a", "b\x00c", result, "a")
+		}
+	})
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	withCacheOptions(t, CacheOptions{Size: 16}, func() {
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 200 {
+					value := fmt.Sprintf("worker %d item %d", i, j%40)
+					if result := Snake(Studly(value), "_"); result == "" {
+						t.Errorf("Snake(Studly(%q)) is empty", value)
+					}
+					Camel(value)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if entries := CacheStatistics().Entries; entries > 3*16 {
+			t.Errorf("Entries = %d, want at most %d", entries, 3*16)
+		}
+	})
+}
@@ -0,0 +1,359 @@
+package str
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// inflectionRule replaces the end of a word matching pattern.
+type inflectionRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// englishInflector turns English words into their plural and singular forms.
+// Rules are tried in order and the first match wins.
+type englishInflector struct {
+	mu            sync.RWMutex
+	plurals       []inflectionRule
+	singulars     []inflectionRule
+	irregulars    map[string]string // singular to plural
+	irregularsInv map[string]string // plural to singular
+	uncountables  map[string]bool
+}
+
+var english = newEnglishInflector()
+
+// singularsEndingInS are words that end in s in the singular and take -es in
+// the plural, so the plain -s rules would damage them.
+const singularsEndingInS = "alias|status|campus|bonus|canvas|gas|atlas|census|lens|iris|bias|apparatus|prospectus|virus"
+
+// nounsEndingInChe are words ending in -che, whose plurals would otherwise
+// lose the e along with the -es of words such as "church".
+const nounsEndingInChe = "cache|niche|^ache|headache|toothache|stomachache|backache|earache|heartache|avalanche|moustache|mustache|quiche|cliche|creche|psyche|microfiche"
+
+func newEnglishInflector() *englishInflector {
+	in := &englishInflector{
+		irregulars:    make(map[string]string),
+		irregularsInv: make(map[string]string),
+		uncountables:  make(map[string]bool),
+	}
+
+	for _, rule := range [][2]string{
+		{`(quiz)$`, "${1}zes"},
+		{`^(oxen)$`, "${1}"},
+		{`^(ox)$`, "${1}en"},
+		{`^(m|l)ice$`, "${1}ice"},
+		{`^(m|l)ouse$`, "${1}ice"},
+		{`(matr|vert|ind)(?:ix|ex)$`, "${1}ices"},
+		{`(x|ch|ss|sh)$`, "${1}es"},
+		{`([^aeiouy]|qu)y$`, "${1}ies"},
+		{`(hive)$`, "${1}s"},
+		{`(?:([^f])fe|([lr])f)$`, "${1}${2}ves"},
+		{`sis$`, "ses"},
+		{`([ti])a$`, "${1}a"},
+		{`([ti])um$`, "${1}a"},
+		{`(buffal|tomat|potat|her|ech|vet)o$`, "${1}oes"},
+		{`(bu)s$`, "${1}ses"},
+		{`(` + singularsEndingInS + `)$`, "${1}es"},
+		{`(octop)i$`, "${1}i"},
+		{`(octop)us$`, "${1}i"},
+		{`^(ax|test)is$`, "${1}es"},
+		{`s$`, "s"},
+		{`$`, "s"},
+	} {
+		in.plurals = append(in.plurals, mustInflectionRule(rule[0], rule[1]))
+	}
+
+	for _, rule := range [][2]string{
+		{`(database)s$`, "${1}"},
+		{`(quiz)zes$`, "${1}"},
+		{`(matr)ices$`, "${1}ix"},
+		{`(vert|ind)ices$`, "${1}ex"},
+		{`^(ox)en$`, "${1}"},
+		{`(` + singularsEndingInS + `)(es)?$`, "${1}"},
+		{`(octop)(us|i)$`, "${1}us"},
+		{`^(a)x[ie]s$`, "${1}xis"},
+		{`(cris|test)(is|es)$`, "${1}is"},
+		{`(shoe)s$`, "${1}"},
+		{`(o)es$`, "${1}"},
+		{`(bus)(es)?$`, "${1}"},
+		{`^(m|l)ice$`, "${1}ouse"},
+		{`(` + nounsEndingInChe + `)s$`, "${1}"},
+		{`(x|ch|ss|sh)es$`, "${1}"},
+		{`(m)ovies$`, "${1}ovie"},
+		{`(s)eries$`, "${1}eries"},
+		{`([^aeiouy]|qu)ies$`, "${1}y"},
+		{`([lr])ves$`, "${1}f"},
+		{`(tive)s$`, "${1}"},
+		{`(hive)s$`, "${1}"},
+		{`([^f])ves$`, "${1}fe"},
+		{`(^analy)(sis|ses)$`, "${1}sis"},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, "${1}sis"},
+		{`([ti])a$`, "${1}um"},
+		{`(n)ews$`, "${1}ews"},
+		{`(ss)$`, "${1}"},
+		{`s$`, ""},
+	} {
+		in.singulars = append(in.singulars, mustInflectionRule(rule[0], rule[1]))
+	}
+
+	for singular, plural := range map[string]string{
+		"person": "people", "man": "men", "woman": "women", "child": "children",
+		"sex": "sexes", "move": "moves", "zombie": "zombies", "goose": "geese",
+		"foot": "feet", "tooth": "teeth", "criterion": "criteria",
+		"phenomenon": "phenomena", "cactus": "cacti", "focus": "foci",
+		"fungus": "fungi", "nucleus": "nuclei", "radius": "radii",
+		"stimulus": "stimuli", "syllabus": "syllabi", "genus": "genera",
+		"die": "dice", "toe": "toes", "canoe": "canoes", "cookie": "cookies",
+		"leaf": "leaves", "loaf": "loaves", "thief": "thieves",
+		"passerby": "passersby", "safe": "safes", "cafe": "cafes",
+		"cache": "caches", "tie": "ties", "pie": "pies", "lie": "lies",
+	} {
+		in.irregulars[singular] = plural
+		in.irregularsInv[plural] = singular
+	}
+
+	for _, word := range []string{
+		"audio", "bison", "cattle", "chassis", "compensation", "data", "deer",
+		"education", "emoji", "equipment", "evidence", "feedback", "firmware",
+		"fish", "furniture", "gold", "hardware", "information", "jeans", "kin",
+		"knowledge", "metadata", "money", "moose", "news", "nutrition",
+		"offspring", "plankton", "police", "rice", "series", "sheep", "software",
+		"species", "swine", "traffic", "wheat",
+	} {
+		in.uncountables[word] = true
+	}
+	return in
+}
+
+// mustInflectionRule compiles a built-in rule, matching case-insensitively.
+func mustInflectionRule(pattern, replacement string) inflectionRule {
+	return inflectionRule{regexp.MustCompile(`(?i)` + pattern), replacement}
+}
+
+// Plural returns the plural form of an English word, or the word itself when
+// count is 1 or -1. Only the last word of value is inflected, including the
+// last word of a StudlyCase value, and its case is kept, so "Person" becomes
+// "People" and "BOX" becomes "BOXES". Initialisms registered with
+// RegisterInitialisms, and capitals at the end of a StudlyCase value, take a
+// lowercase "s", so "UserID" becomes "UserIDs". Uncountable words such as
+// "sheep" are returned unchanged.
+func Plural(value string, count int) string {
+	if count == 1 || count == -1 {
+		return value
+	}
+	return english.Plural(value)
+}
+
+// Singular returns the singular form of an English word. Only the last word
+// of value is inflected, and its case is kept, so "UserIDs" becomes "UserID".
+func Singular(value string) string {
+	return english.Singular(value)
+}
+
+// PluralStudly returns the plural form of the last word of a StudlyCase value,
+// as split by SplitWords, so "VerifiedPerson" becomes "VerifiedPeople" and
+// "UserID" becomes "UserIDs". The value is returned unchanged when count is 1
+// or -1.
+func PluralStudly(value string, count int) string {
+	return Plural(value, count)
+}
+
+// RegisterIrregular registers an English word whose plural does not follow
+// the rules, such as "person" and "people".
+func RegisterIrregular(singular, plural string) {
+	english.mu.Lock()
+	defer english.mu.Unlock()
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	english.irregulars[singular] = plural
+	english.irregularsInv[plural] = singular
+	delete(english.uncountables, singular)
+	delete(english.uncountables, plural)
+}
+
+// RegisterUncountable registers English words that have no plural form, such
+// as "equipment".
+func RegisterUncountable(words ...string) {
+	english.mu.Lock()
+	defer english.mu.Unlock()
+	for _, word := range words {
+		english.uncountables[strings.ToLower(word)] = true
+	}
+}
+
+// RegisterPluralRule registers a rule that makes a word plural by replacing
+// the text matched by pattern, as regexp.ReplaceAllString does. The pattern
+// matches case-insensitively and takes precedence over the built-in rules.
+func RegisterPluralRule(pattern, replacement string) error {
+	return english.addRule(&english.plurals, pattern, replacement)
+}
+
+// RegisterSingularRule registers a rule that makes a word singular by
+// replacing the text matched by pattern, as regexp.ReplaceAllString does. The
+// pattern matches case-insensitively and takes precedence over the built-in
+// rules.
+func RegisterSingularRule(pattern, replacement string) error {
+	return english.addRule(&english.singulars, pattern, replacement)
+}
+
+// addRule compiles pattern and puts the rule in front of rules.
+func (in *englishInflector) addRule(rules *[]inflectionRule, pattern, replacement string) error {
+	re, err := regexp.Compile(`(?i)` + pattern)
+	if err != nil {
+		return fmt.Errorf("invalid inflection rule %q: %w", pattern, err)
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	*rules = append([]inflectionRule{{re, replacement}}, *rules...)
+	return nil
+}
+
+// Plural returns the plural form of the last word of value.
+func (in *englishInflector) Plural(value string) string {
+	return in.inflect(value, func(word string, acronym bool) string {
+		if acronym {
+			return word + "s"
+		}
+		lower := strings.ToLower(word)
+		if _, ok := in.irregularsInv[lower]; ok {
+			return word
+		}
+		if plural, ok := in.irregulars[lower]; ok {
+			return matchCase(word, plural)
+		}
+		return applyRules(word, in.plurals)
+	})
+}
+
+// Singular returns the singular form of the last word of value.
+func (in *englishInflector) Singular(value string) string {
+	if head, word := lastWord(value); isAcronymPlural(word) {
+		return head + strings.TrimSuffix(word, "s")
+	}
+	return in.inflect(value, func(word string, acronym bool) string {
+		if acronym {
+			return word
+		}
+		lower := strings.ToLower(word)
+		if _, ok := in.irregulars[lower]; ok {
+			return word
+		}
+		if singular, ok := in.irregularsInv[lower]; ok {
+			return matchCase(word, singular)
+		}
+		return applyRules(word, in.singulars)
+	})
+}
+
+// Inflect returns value unchanged for the category one, as labels are
+// written in the singular, and its plural form otherwise.
+func (in *englishInflector) Inflect(value string, category PluralCategory) string {
+	if category == PluralOne {
+		return value
+	}
+	return in.Plural(value)
+}
+
+// inflect applies fn to the last word of value, leaving uncountable words and
+// values that do not end in a letter unchanged. The last word of a StudlyCase
+// value is inflected on its own, and fn is told whether it is an acronym, a
+// registered initialism or capitals after other letters as in "UserID".
+func (in *englishInflector) inflect(value string, fn func(word string, acronym bool) string) string {
+	head, word := lastWord(value)
+	if word == "" {
+		return value
+	}
+
+	_, acronym := initialism(word)
+	if spans := wordSpans(word); len(spans) > 1 {
+		start := spans[len(spans)-1].start
+		head, word = head+word[:start], word[start:]
+		_, acronym = initialism(word)
+		acronym = acronym || isUpperWord(word)
+	}
+
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+	if in.uncountables[strings.ToLower(word)] {
+		return value
+	}
+	return head + fn(word, acronym)
+}
+
+// isAcronymPlural reports whether word is the plural of an acronym, capitals
+// followed by a lowercase "s" as in "IDs" or "UserIDs".
+func isAcronymPlural(word string) bool {
+	stem, found := strings.CutSuffix(word, "s")
+	if !found {
+		return false
+	}
+	if _, ok := initialism(stem); ok {
+		return true
+	}
+	_, last := lastUpperRun(stem)
+	return utf8.RuneCountInString(last) > 1
+}
+
+// lastUpperRun splits value before the capitals it ends with.
+func lastUpperRun(value string) (head, run string) {
+	start := len(value)
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(value[:start])
+		if !isUpperRune(r) {
+			break
+		}
+		start -= size
+	}
+	return value[:start], value[start:]
+}
+
+// lastWord splits value before the letters it ends with.
+func lastWord(value string) (head, word string) {
+	start := len(value)
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(value[:start])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		start -= size
+	}
+	return value[:start], value[start:]
+}
+
+// applyRules applies the first rule that matches word, keeping an all
+// uppercase word in uppercase.
+func applyRules(word string, rules []inflectionRule) string {
+	for _, rule := range rules {
+		if rule.pattern.MatchString(word) {
+			result := rule.pattern.ReplaceAllString(word, rule.replacement)
+			if isUpperWord(word) {
+				return strings.ToUpper(result)
+			}
+			return result
+		}
+	}
+	return word
+}
+
+// matchCase returns replacement in the case of word: all uppercase,
+// capitalised or as given.
+func matchCase(word, replacement string) string {
+	if isUpperWord(word) {
+		return strings.ToUpper(replacement)
+	}
+	if r, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(r) {
+		return Ucfirst(replacement)
+	}
+	return replacement
+}
+
+// isUpperWord reports whether word has more than one letter and no lowercase
+// letters, so a single capital such as "A" counts as capitalised.
+func isUpperWord(word string) bool {
+	return utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word
+}
@@ -0,0 +1,204 @@
+package str
+
+import "testing"
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		value    string
+		count    int
+		expected string
+	}{
+		{"car", 2, "cars"},
+		{"car", 1, "car"},
+		{"car", -1, "car"},
+		{"car", 0, "cars"},
+		{"box", 2, "boxes"},
+		{"church", 2, "churches"},
+		{"category", 2, "categories"},
+		{"day", 2, "days"},
+		{"knife", 2, "knives"},
+		{"wolf", 2, "wolves"},
+		{"roof", 2, "roofs"},
+		{"hero", 2, "heroes"},
+		{"photo", 2, "photos"},
+		{"analysis", 2, "analyses"},
+		{"matrix", 2, "matrices"},
+		{"index", 2, "indices"},
+		{"octopus", 2, "octopi"},
+		{"quiz", 2, "quizzes"},
+		{"mouse", 2, "mice"},
+		{"status", 2, "statuses"},
+		{"bonus", 2, "bonuses"},
+		{"gas", 2, "gases"},
+		{"canvas", 2, "canvases"},
+		{"bus", 2, "buses"},
+		{"virus", 2, "viruses"},
+		{"safe", 2, "safes"},
+		{"cafe", 2, "cafes"},
+		{"cache", 2, "caches"},
+		{"niche", 2, "niches"},
+		{"tie", 2, "ties"},
+		{"pie", 2, "pies"},
+		{"person", 2, "people"},
+		{"child", 2, "children"},
+		{"tooth", 2, "teeth"},
+		{"criterion", 2, "criteria"},
+		{"sheep", 2, "sheep"},
+		{"information", 2, "information"},
+		{"people", 2, "people"},
+		{"categories", 2, "categories"},
+		{"Person", 2, "People"},
+		{"PERSON", 2, "PEOPLE"},
+		{"Category", 2, "Categories"},
+		{"BOX", 2, "BOXES"},
+		{"iPhone", 2, "iPhones"},
+		{"UserID", 2, "UserIDs"},
+		{"userID", 2, "userIDs"},
+		{"ParseURL", 2, "ParseURLs"},
+		{"user account", 2, "user accounts"},
+		{"user_child", 2, "user_children"},
+		{"human", 2, "humans"},
+		{"café", 2, "cafés"},
+		{"", 2, ""},
+		{"item 2", 2, "item 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if result := Plural(tt.value, tt.count); result != tt.expected {
+				t.Errorf("Plural(%q, %d) = %q, want %q", tt.value, tt.count, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSingular(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"cars", "car"},
+		{"boxes", "box"},
+		{"churches", "church"},
+		{"categories", "category"},
+		{"days", "day"},
+		{"knives", "knife"},
+		{"wolves", "wolf"},
+		{"heroes", "hero"},
+		{"shoes", "shoe"},
+		{"analyses", "analysis"},
+		{"matrices", "matrix"},
+		{"indices", "index"},
+		{"octopi", "octopus"},
+		{"quizzes", "quiz"},
+		{"mice", "mouse"},
+		{"statuses", "status"},
+		{"bonuses", "bonus"},
+		{"bonus", "bonus"},
+		{"gas", "gas"},
+		{"gases", "gas"},
+		{"canvas", "canvas"},
+		{"ideas", "idea"},
+		{"buses", "bus"},
+		{"viruses", "virus"},
+		{"virus", "virus"},
+		{"safes", "safe"},
+		{"cafes", "cafe"},
+		{"caches", "cache"},
+		{"niches", "niche"},
+		{"headaches", "headache"},
+		{"beaches", "beach"},
+		{"coaches", "coach"},
+		{"ties", "tie"},
+		{"pies", "pie"},
+		{"flies", "fly"},
+		{"movies", "movie"},
+		{"databases", "database"},
+		{"people", "person"},
+		{"children", "child"},
+		{"leaves", "leaf"},
+		{"cookies", "cookie"},
+		{"sheep", "sheep"},
+		{"news", "news"},
+		{"class", "class"},
+		{"person", "person"},
+		{"car", "car"},
+		{"People", "Person"},
+		{"CHILDREN", "CHILD"},
+		{"Categories", "Category"},
+		{"user accounts", "user account"},
+		{"UserIDs", "UserID"},
+		{"CDs", "CD"},
+		{"BOXES", "BOX"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if result := Singular(tt.value); result != tt.expected {
+				t.Errorf("Singular(%q) = %q, want %q", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPluralStudly(t *testing.T) {
+	tests := []struct {
+		value    string
+		count    int
+		expected string
+	}{
+		{"VerifiedHuman", 2, "VerifiedHumans"},
+		{"UserCategory", 2, "UserCategories"},
+		{"SalesPerson", 2, "SalesPeople"},
+		{"SalesPerson", 1, "SalesPerson"},
+		{"Child", 2, "Children"},
+		{"UserID", 2, "UserIDs"},
+		{"HTTPServer", 2, "HTTPServers"},
+		{"ProductData", 2, "ProductData"},
+		{"", 2, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if result := PluralStudly(tt.value, tt.count); result != tt.expected {
+				t.Errorf("PluralStudly(%q, %d) = %q, want %q", tt.value, tt.count, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterInflections(t *testing.T) {
+	RegisterIrregular("Alumnus", "Alumni")
+	RegisterUncountable("Aircraft")
+	if err := RegisterPluralRule(`(ptar)$`, "${1}men"); err != nil {
+		t.Fatalf("RegisterPluralRule() error = %v", err)
+	}
+	if err := RegisterSingularRule(`(ptar)men$`, "${1}"); err != nil {
+		t.Fatalf("RegisterSingularRule() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		fn       func() string
+		expected string
+	}{
+		{"irregular plural", func() string { return Plural("alumnus", 2) }, "alumni"},
+		{"irregular singular", func() string { return Singular("Alumni") }, "Alumnus"},
+		{"uncountable", func() string { return Plural("aircraft", 2) }, "aircraft"},
+		{"plural rule", func() string { return Plural("Reptar", 2) }, "Reptarmen"},
+		{"singular rule", func() string { return Singular("reptarmen") }, "reptar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.fn(); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	if err := RegisterPluralRule(`(`, ""); err == nil {
+		t.Error("RegisterPluralRule() with an invalid pattern returned no error")
+	}
+}
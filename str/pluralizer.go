@@ -0,0 +1,206 @@
+package str
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rulzi/helper-go/internal/langtag"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// PluralCategory is a CLDR plural category, which selects the form of a word
+// that follows a number. The zero value is PluralOther.
+type PluralCategory int
+
+const (
+	// PluralOther is used by every language, such as for 2 in English.
+	PluralOther PluralCategory = iota
+	// PluralZero is used for 0 in languages such as Latvian.
+	PluralZero
+	// PluralOne is used for 1 in English and for 1, 21 and 31 in Russian.
+	PluralOne
+	// PluralTwo is used for 2 in languages such as Arabic and Welsh.
+	PluralTwo
+	// PluralFew is used for 2 to 4 in Russian and Polish.
+	PluralFew
+	// PluralMany is used for 5 to 20 in Russian and Polish.
+	PluralMany
+)
+
+// String returns the CLDR name of the plural category.
+func (c PluralCategory) String() string {
+	switch c {
+	case PluralOther:
+		return "other"
+	case PluralZero:
+		return "zero"
+	case PluralOne:
+		return "one"
+	case PluralTwo:
+		return "two"
+	case PluralFew:
+		return "few"
+	case PluralMany:
+		return "many"
+	default:
+		return "PluralCategory(" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+// Inflector turns the words of one language into their plural and singular
+// forms.
+type Inflector interface {
+	// Plural returns the plural form of value, as used without a number.
+	Plural(value string) string
+	// Singular returns the singular form of value.
+	Singular(value string) string
+	// Inflect returns the form of value used after a number in the given
+	// plural category.
+	Inflect(value string, category PluralCategory) string
+}
+
+var (
+	// Mutex for thread-safe access to the pluralizer registry
+	pluralizersMu sync.RWMutex
+
+	// Inflectors by language tag, such as "en" or "id", looked up with
+	// langtag.Lookup
+	pluralizers = map[string]Inflector{
+		"en": english,
+		"id": indonesianInflector{},
+	}
+)
+
+// RegisterPluralizer registers the Inflector used for the given language and
+// the languages that inherit from it in CLDR, so an Inflector for "pt" also
+// serves "pt-BR" unless that has one of its own.
+func RegisterPluralizer(lang string, inflector Inflector) {
+	pluralizersMu.Lock()
+	defer pluralizersMu.Unlock()
+	pluralizers[language.Make(lang).String()] = inflector
+}
+
+// Pluralizer returns the Inflector for the given language. An empty language
+// uses English, and languages without an Inflector get one that leaves words
+// unchanged rather than applying English rules to them.
+func Pluralizer(lang string) Inflector {
+	if lang == "" {
+		return english
+	}
+
+	pluralizersMu.RLock()
+	defer pluralizersMu.RUnlock()
+
+	if inflector, ok := langtag.Lookup(pluralizers, language.Make(lang)); ok {
+		return inflector
+	}
+	return identityInflector{}
+}
+
+// PluralCategoryFor returns the CLDR plural category of count in the given
+// language, such as PluralFew for 3 in Russian. An empty language is English.
+func PluralCategoryFor(count int, lang string) PluralCategory {
+	if count < 0 {
+		count = -count
+	}
+	tag := language.Make(lang)
+	if tag == language.Und {
+		tag = language.English
+	}
+	// Only the last digits matter, as allowed by MatchPlural
+	form := plural.Cardinal.MatchPlural(tag, count%10000000, 0, 0, 0, 0)
+	switch form {
+	case plural.Zero:
+		return PluralZero
+	case plural.One:
+		return PluralOne
+	case plural.Two:
+		return PluralTwo
+	case plural.Few:
+		return PluralFew
+	case plural.Many:
+		return PluralMany
+	default:
+		return PluralOther
+	}
+}
+
+// PluralFor returns the form of value used after count in the given language,
+// so "item" becomes "items" for 3 in English and "buku" stays "buku" in
+// Indonesian, which does not inflect nouns after a number.
+func PluralFor(value string, count int, lang string) string {
+	return Pluralizer(lang).Inflect(value, PluralCategoryFor(count, lang))
+}
+
+// PluralLabel inflects the last word of a label for count in the given
+// language and replaces "{count}" with the number, so "{count} item" becomes
+// "3 items" in English. Labels that do not end in a word are not inflected.
+func PluralLabel(label string, count int, lang string) string {
+	if head, word := lastWord(label); word != "" {
+		label = head + PluralFor(word, count, lang)
+	}
+	return strings.ReplaceAll(label, "{count}", strconv.Itoa(count))
+}
+
+// identityInflector leaves words unchanged, for languages without an Inflector.
+type identityInflector struct{}
+
+func (identityInflector) Plural(value string) string                    { return value }
+func (identityInflector) Singular(value string) string                  { return value }
+func (identityInflector) Inflect(value string, _ PluralCategory) string { return value }
+
+// indonesianInflector forms Indonesian plurals by reduplication, so "buku"
+// becomes "buku-buku". Nouns are not inflected after a number.
+type indonesianInflector struct{}
+
+// Plural reduplicates the first word of value, the head of an Indonesian noun
+// phrase, so "kartu kredit" becomes "kartu-kartu kredit".
+func (indonesianInflector) Plural(value string) string {
+	word, rest := indonesianHead(value)
+	if word == "" || isReduplicated(word, rest) {
+		return value
+	}
+	second := word
+	if !isUpperWord(word) {
+		second = strings.ToLower(word)
+	}
+	return word + "-" + second + rest
+}
+
+// Singular removes the reduplication of the first word of value.
+func (indonesianInflector) Singular(value string) string {
+	word, rest := indonesianHead(value)
+	if word == "" || !isReduplicated(word, rest) {
+		return value
+	}
+	return word + rest[len(word)+1:]
+}
+
+// Inflect returns value unchanged, as Indonesian nouns keep their form after
+// a number.
+func (indonesianInflector) Inflect(value string, _ PluralCategory) string {
+	return value
+}
+
+// indonesianHead splits value into its leading word and the rest.
+func indonesianHead(value string) (word, rest string) {
+	end := 0
+	for end < len(value) {
+		r, size := utf8.DecodeRuneInString(value[end:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		end += size
+	}
+	return value[:end], value[end:]
+}
+
+// isReduplicated reports whether rest starts with a hyphen and word again.
+func isReduplicated(word, rest string) bool {
+	next, _ := indonesianHead(strings.TrimPrefix(rest, "-"))
+	return strings.HasPrefix(rest, "-") && strings.EqualFold(word, next)
+}
@@ -0,0 +1,163 @@
+package str
+
+import "testing"
+
+// russianInflector is a test Inflector with the forms of one Russian word.
+type russianInflector struct{}
+
+func (russianInflector) Plural(value string) string   { return "файлы" }
+func (russianInflector) Singular(value string) string { return "файл" }
+func (russianInflector) Inflect(value string, category PluralCategory) string {
+	switch category {
+	case PluralOne:
+		return "файл"
+	case PluralFew:
+		return "файла"
+	default:
+		return "файлов"
+	}
+}
+
+func TestPluralCategoryFor(t *testing.T) {
+	tests := []struct {
+		count    int
+		language string
+		expected PluralCategory
+	}{
+		{1, "en", PluralOne},
+		{0, "en", PluralOther},
+		{2, "en", PluralOther},
+		{-1, "en", PluralOne},
+		{1, "id", PluralOther},
+		{1, "ru", PluralOne},
+		{3, "ru", PluralFew},
+		{5, "ru", PluralMany},
+		{21, "ru", PluralOne},
+		{0, "fr", PluralOne},
+		{2, "ar", PluralTwo},
+		{0, "lv", PluralZero},
+		{1, "", PluralOne},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			if result := PluralCategoryFor(tt.count, tt.language); result != tt.expected {
+				t.Errorf("PluralCategoryFor(%d, %q) = %v, want %v", tt.count, tt.language, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIndonesianInflector(t *testing.T) {
+	inflector := Pluralizer("id")
+
+	plurals := []struct {
+		value    string
+		expected string
+	}{
+		{"buku", "buku-buku"},
+		{"Buku", "Buku-buku"},
+		{"BUKU", "BUKU-BUKU"},
+		{"kartu kredit", "kartu-kartu kredit"},
+		{"buku-buku", "buku-buku"},
+		{"", ""},
+	}
+	for _, tt := range plurals {
+		if result := inflector.Plural(tt.value); result != tt.expected {
+			t.Errorf("Plural(%q) = %q, want %q", tt.value, result, tt.expected)
+		}
+	}
+
+	singulars := []struct {
+		value    string
+		expected string
+	}{
+		{"buku-buku", "buku"},
+		{"Buku-buku", "Buku"},
+		{"kartu-kartu kredit", "kartu kredit"},
+		{"buku", "buku"},
+		{"tanya-jawab", "tanya-jawab"},
+	}
+	for _, tt := range singulars {
+		if result := inflector.Singular(tt.value); result != tt.expected {
+			t.Errorf("Singular(%q) = %q, want %q", tt.value, result, tt.expected)
+		}
+	}
+}
+
+func TestPluralizer(t *testing.T) {
+	RegisterPluralizer("ru", russianInflector{})
+
+	tests := []struct {
+		name     string
+		language string
+		value    string
+		expected string
+	}{
+		{"english", "en", "person", "people"},
+		{"english region", "en-GB", "category", "categories"},
+		{"indonesian", "id", "buku", "buku-buku"},
+		{"indonesian region", "id-ID", "buku", "buku-buku"},
+		{"underscore region", "id_ID", "buku", "buku-buku"},
+		{"english through cldr parent", "en-AU", "box", "boxes"},
+		{"registered", "ru", "файл", "файлы"},
+		{"unregistered", "fr", "livre", "livre"},
+		{"unregistered region", "pl-PL", "książka", "książka"},
+		{"unknown", "xx", "item", "item"},
+		{"empty", "", "item", "items"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Pluralizer(tt.language).Plural(tt.value); result != tt.expected {
+				t.Errorf("Pluralizer(%q).Plural(%q) = %q, want %q", tt.language, tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPluralLabel(t *testing.T) {
+	RegisterPluralizer("ru", russianInflector{})
+
+	tests := []struct {
+		label    string
+		count    int
+		language string
+		expected string
+	}{
+		{"{count} item", 1, "en", "1 item"},
+		{"{count} item", 3, "en", "3 items"},
+		{"{count} item", 0, "en", "0 items"},
+		{"{count} bonus", 1, "en", "1 bonus"},
+		{"{count} canvas", 1, "en", "1 canvas"},
+		{"{count} bonus", 2, "en", "2 bonuses"},
+		{"{count} new person", 2, "en", "2 new people"},
+		{"{count} buku", 3, "id", "3 buku"},
+		{"{count} файл", 1, "ru", "1 файл"},
+		{"{count} файл", 3, "ru", "3 файла"},
+		{"{count} файл", 5, "ru", "5 файлов"},
+		{"{count} файл", 21, "ru", "21 файл"},
+		{"items: {count}", 3, "en", "items: 3"},
+		{"{count} книга", 3, "uk", "3 книга"},
+		{"{count} livre", 3, "fr", "3 livre"},
+		{"{count} item", 3, "xx", "3 item"},
+		{"{count} item", 3, "", "3 items"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			if result := PluralLabel(tt.label, tt.count, tt.language); result != tt.expected {
+				t.Errorf("PluralLabel(%q, %d, %q) = %q, want %q", tt.label, tt.count, tt.language, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPluralFor(t *testing.T) {
+	if result := PluralFor("box", 2, "en"); result != "boxes" {
+		t.Errorf("PluralFor(%q, 2, %q) = %q, want %q", "box", "en", result, "boxes")
+	}
+	if result := PluralFor("buku", 2, "id"); result != "buku" {
+		t.Errorf("PluralFor(%q, 2, %q) = %q, want %q", "buku", "id", result, "buku")
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 
@@ -16,13 +18,16 @@ import (
 )
 
 var (
-	snakeCache  = make(map[string]map[string]string)
-	camelCache  = make(map[string]string)
-	studlyCache = make(map[string]string)
+	randomMu            sync.RWMutex
+	randomStringFactory func(length int) string
+
 	// Pre-compiled regex patterns for common use cases
 	numbersRegex = regexp.MustCompile(`[^0-9]`)
 	squishRegex  = regexp.MustCompile(`\s+`)
 	uuidRegex    = regexp.MustCompile(`^[\da-fA-F]{8}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{4}-[\da-fA-F]{12}$`)
+
+	// Words Slug replaces when no dictionary is given
+	defaultSlugDictionary = map[string]string{"@": "at"}
 )
 
 // After returns the remainder of a string after the first occurrence of a given value.
@@ -89,14 +94,22 @@ func BetweenFirst(subject, from, to string) string {
 	return Before(After(subject, from), to)
 }
 
-// Camel converts a value to camel case.
+// Camel converts a value to camel case, splitting it into words as
+// SplitWords does.
 func Camel(value string) string {
-	if cached, ok := camelCache[value]; ok {
-		return cached
-	}
-	result := Lcfirst(Studly(value))
-	camelCache[value] = result
-	return result
+	return cached(camelCache, value, func() string {
+		words := SplitWords(value)
+		var result strings.Builder
+		result.Grow(len(value))
+		for i, word := range words {
+			if i == 0 {
+				result.WriteString(camelWord(word))
+			} else {
+				result.WriteString(studlyWord(word))
+			}
+		}
+		return result.String()
+	})
 }
 
 // CharAt returns the character at the specified index.
@@ -432,11 +445,35 @@ func Position(haystack, needle string, offset int) int {
 }
 
 // Random generates a more truly "random" alpha-numeric string.
+// The result can be faked with CreateRandomStringsUsing.
 func Random(length int) string {
 	if length <= 0 {
 		return ""
 	}
 
+	randomMu.RLock()
+	factory := randomStringFactory
+	randomMu.RUnlock()
+
+	if factory != nil {
+		return factory(length)
+	}
+
+	result, err := RandomWith(rand.Reader, length)
+	if err != nil {
+		panic("failed to generate random bytes: " + err.Error())
+	}
+	return result
+}
+
+// RandomWith generates a random alpha-numeric string reading bytes from the
+// given source. Passing a seeded *rand.Rand from math/rand produces a
+// reproducible string.
+func RandomWith(source io.Reader, length int) (string, error) {
+	if length <= 0 {
+		return "", nil
+	}
+
 	var result strings.Builder
 	result.Grow(length)
 
@@ -445,8 +482,8 @@ func Random(length int) string {
 		bytesSize := (size/3 + 1) * 3
 
 		bytes := make([]byte, bytesSize)
-		if _, err := rand.Read(bytes); err != nil {
-			panic("failed to generate random bytes: " + err.Error())
+		if _, err := io.ReadFull(source, bytes); err != nil {
+			return "", err
 		}
 
 		encoded := base64.URLEncoding.EncodeToString(bytes)
@@ -462,7 +499,48 @@ func Random(length int) string {
 		result.WriteString(encoded)
 	}
 
-	return result.String()[:length]
+	return result.String()[:length], nil
+}
+
+// CreateRandomStringsUsing sets the callback that will be used to generate random strings.
+func CreateRandomStringsUsing(factory func(length int) string) {
+	randomMu.Lock()
+	defer randomMu.Unlock()
+	randomStringFactory = factory
+}
+
+// CreateRandomStringsUsingSequence sets the sequence that will be used to
+// generate random strings. Once the sequence is exhausted, whenMissing is
+// used, or a normal random string if whenMissing is nil.
+func CreateRandomStringsUsingSequence(sequence []string, whenMissing func(length int) string) {
+	var mu sync.Mutex
+	next := 0
+
+	CreateRandomStringsUsing(func(length int) string {
+		mu.Lock()
+		if next < len(sequence) {
+			value := sequence[next]
+			next++
+			mu.Unlock()
+			return value
+		}
+		mu.Unlock()
+
+		if whenMissing != nil {
+			return whenMissing(length)
+		}
+
+		result, err := RandomWith(rand.Reader, length)
+		if err != nil {
+			panic("failed to generate random bytes: " + err.Error())
+		}
+		return result
+	})
+}
+
+// CreateRandomStringsNormally indicates that random strings should be created normally and not using a custom factory.
+func CreateRandomStringsNormally() {
+	CreateRandomStringsUsing(nil)
 }
 
 // Repeat repeats the given string.
@@ -575,88 +653,58 @@ func Upper(value string) string {
 	return strings.ToUpper(value)
 }
 
-// Title converts the given string to proper case.
+// Title converts the given string to proper case. Each word, as split by
+// SplitWords, is capitalised and the rest of it lowercased, keeping the text
+// between words unchanged.
 func Title(value string) string {
 	// Use cases.Title which properly handles Unicode
-	t := cases.Title(language.Und, cases.NoLower)
-	return t.String(strings.ToLower(value))
-}
-
-// Snake converts a string to snake case.
-func Snake(value, delimiter string) string {
-	if cache, ok := snakeCache[value]; ok {
-		if cached, ok := cache[delimiter]; ok {
-			return cached
-		}
-	}
-
-	// Check if already lowercase
-	allLower := true
-	for _, r := range value {
-		if unicode.IsUpper(r) {
-			allLower = false
-			break
-		}
-	}
+	caser := cases.Title(language.Und)
 
-	if allLower {
-		if snakeCache[value] == nil {
-			snakeCache[value] = make(map[string]string)
-		}
-		snakeCache[value][delimiter] = value
-		return value
-	}
-
-	// Convert to snake case
 	var result strings.Builder
-	runes := []rune(value)
+	result.Grow(len(value))
+	last := 0
+	for _, span := range wordSpans(value) {
+		result.WriteString(value[last:span.start])
+		result.WriteString(titleWord(caser, value[span.start:span.end]))
+		last = span.end
+	}
+	result.WriteString(value[last:])
+	return result.String()
+}
 
-	for i, r := range runes {
-		if unicode.IsUpper(r) {
-			if i > 0 {
-				result.WriteString(delimiter)
-			}
-			result.WriteRune(unicode.ToLower(r))
-		} else if r == ' ' || r == '-' || r == '_' {
-			result.WriteString(delimiter)
-		} else {
-			result.WriteRune(r)
-		}
-	}
+// Snake converts a string to snake case, splitting it into words as
+// SplitWords does, so "HTTPServer" becomes "http_server".
+func Snake(value, delimiter string) string {
+	return cached(snakeCache, snakeKey{value, delimiter}, func() string {
+		return snake(value, delimiter)
+	})
+}
 
-	resultStr := result.String()
-	if snakeCache[value] == nil {
-		snakeCache[value] = make(map[string]string)
+// snake converts a string to snake case without using the cache.
+func snake(value, delimiter string) string {
+	words := SplitWords(value)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
 	}
-	snakeCache[value][delimiter] = resultStr
-	return resultStr
+	return strings.Join(words, delimiter)
 }
 
-// Studly converts a value to studly caps case.
+// Studly converts a value to studly caps case, splitting it into words as
+// SplitWords does. Registered initialisms keep their case.
 func Studly(value string) string {
-	// Use original value as cache key
-	if cached, ok := studlyCache[value]; ok {
-		return cached
-	}
-
-	originalValue := value
-	// Replace hyphens and underscores with spaces
-	value = strings.ReplaceAll(value, "-", " ")
-	value = strings.ReplaceAll(value, "_", " ")
+	return cached(studlyCache, value, func() string {
+		return studly(value)
+	})
+}
 
-	words := strings.Fields(value)
+// studly converts a value to studly caps case without using the cache.
+func studly(value string) string {
 	var result strings.Builder
-	result.Grow(len(originalValue))
-
-	for _, word := range words {
-		if len(word) > 0 {
-			result.WriteString(Ucfirst(word))
-		}
+	result.Grow(len(value))
+	for _, word := range SplitWords(value) {
+		result.WriteString(studlyWord(word))
 	}
-
-	resultStr := result.String()
-	studlyCache[originalValue] = resultStr
-	return resultStr
+	return result.String()
 }
 
 // Pascal converts a value to Pascal case.
@@ -820,24 +868,10 @@ func Ucfirst(s string) string {
 	return string(runes)
 }
 
-// Ucsplit splits a string into pieces by uppercase characters.
+// Ucsplit splits a string into pieces by uppercase characters, as SplitWords
+// does, so "HTTPServer" splits into "HTTP" and "Server".
 func Ucsplit(s string) []string {
-	var result []string
-	var current strings.Builder
-
-	for _, r := range s {
-		if unicode.IsUpper(r) && current.Len() > 0 {
-			result = append(result, current.String())
-			current.Reset()
-		}
-		current.WriteRune(r)
-	}
-
-	if current.Len() > 0 {
-		result = append(result, current.String())
-	}
-
-	return result
+	return SplitWords(s)
 }
 
 // WordCount returns the number of words a string contains.
@@ -1007,8 +1041,14 @@ func ReplaceMatches(pattern string, replace interface{}, subject string, limit i
 	}
 }
 
-// Slug generates a URL friendly "slug" from a given string.
+// Slug generates a URL friendly "slug" from a given string. The title is
+// transliterated to ASCII with the rules of the given language, as in Ascii.
+// A nil dictionary replaces "@" with "at"; pass an empty map for no words.
 func Slug(title, separator, language string, dictionary map[string]string) string {
+	if dictionary == nil {
+		dictionary = defaultSlugDictionary
+	}
+
 	// Convert to lowercase
 	title = strings.ToLower(title)
 
@@ -1017,6 +1057,9 @@ func Slug(title, separator, language string, dictionary map[string]string) strin
 		title = strings.ReplaceAll(title, k, separator+v+separator)
 	}
 
+	// Transliterate to ASCII
+	title = Ascii(title, language)
+
 	// Remove all characters that are not the separator, letters, numbers, or whitespace
 	re := regexp.MustCompile(`[^` + regexp.QuoteMeta(separator) + `a-z0-9\s]+`)
 	title = re.ReplaceAllString(title, "")
@@ -1030,10 +1073,3 @@ func Slug(title, separator, language string, dictionary map[string]string) strin
 
 	return title
 }
-
-// FlushCache removes all strings from the casing caches.
-func FlushCache() {
-	snakeCache = make(map[string]map[string]string)
-	camelCache = make(map[string]string)
-	studlyCache = make(map[string]string)
-}
@@ -1,6 +1,8 @@
 package str
 
 import (
+	mathrand "math/rand"
+	"strings"
 	"testing"
 )
 
@@ -154,6 +156,10 @@ func TestCamel(t *testing.T) {
 		{"snake case", "hello_world", "helloWorld"},
 		{"kebab case", "hello-world", "helloWorld"},
 		{"already camel", "helloWorld", "helloWorld"},
+		{"acronym", "HTTPServer", "httpServer"},
+		{"capitals", "HELLO world", "helloWorld"},
+		{"digits", "version 2 beta", "version2Beta"},
+		{"dots", "config.file_name", "configFileName"},
 	}
 
 	for _, tt := range tests {
@@ -533,8 +539,10 @@ func TestKebab(t *testing.T) {
 	}{
 		{"camel case", "helloWorld", "hello-world"},
 		{"already kebab", "hello-world", "hello-world"},
-		{"all lowercase", "hello world", "hello world"},
-		{"with uppercase", "Hello World", "hello--world"},
+		{"all lowercase", "hello world", "hello-world"},
+		{"with uppercase", "Hello World", "hello-world"},
+		{"snake case", "hello_world", "hello-world"},
+		{"acronym", "HTTPServer", "http-server"},
 	}
 
 	for _, tt := range tests {
@@ -815,6 +823,52 @@ func TestRandom(t *testing.T) {
 	}
 }
 
+func TestRandomWith(t *testing.T) {
+	first, err := RandomWith(mathrand.New(mathrand.NewSource(42)), 32)
+	if err != nil {
+		t.Fatalf("RandomWith() error = %v", err)
+	}
+	second, _ := RandomWith(mathrand.New(mathrand.NewSource(42)), 32)
+	if first != second || len(first) != 32 {
+		t.Errorf("RandomWith() with same seed = %q and %q, want equal strings of length 32", first, second)
+	}
+
+	if _, err := RandomWith(strings.NewReader("short"), 32); err == nil {
+		t.Errorf("RandomWith() expected error for exhausted source")
+	}
+}
+
+func TestCreateRandomStringsUsing(t *testing.T) {
+	defer CreateRandomStringsNormally()
+
+	CreateRandomStringsUsing(func(length int) string {
+		return strings.Repeat("x", length)
+	})
+	if result := Random(4); result != "xxxx" {
+		t.Errorf("Random(4) = %q, want %q", result, "xxxx")
+	}
+
+	CreateRandomStringsNormally()
+	if result := Random(4); result == "xxxx" || len(result) != 4 {
+		t.Errorf("Random(4) after CreateRandomStringsNormally = %q", result)
+	}
+}
+
+func TestCreateRandomStringsUsingSequence(t *testing.T) {
+	defer CreateRandomStringsNormally()
+
+	CreateRandomStringsUsingSequence([]string{"first", "second"}, func(length int) string {
+		return "missing"
+	})
+
+	expected := []string{"first", "second", "missing"}
+	for _, want := range expected {
+		if result := Random(8); result != want {
+			t.Errorf("Random() = %q, want %q", result, want)
+		}
+	}
+}
+
 func TestRepeat(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1055,6 +1109,9 @@ func TestTitle(t *testing.T) {
 		{"basic", "hello world", "Hello World"},
 		{"already title", "Hello World", "Hello World"},
 		{"uppercase", "HELLO WORLD", "Hello World"},
+		{"separators kept", "hello_world-foo", "Hello_World-Foo"},
+		{"apostrophe", "o'neil's book", "O'neil's Book"},
+		{"unicode", "élan vital", "Élan Vital"},
 		{"empty", "", ""},
 	}
 
@@ -1076,11 +1133,17 @@ func TestSnake(t *testing.T) {
 		expected  string
 	}{
 		{"camel case", "helloWorld", "_", "hello_world"},
-		{"with uppercase and space", "Hello World", "_", "hello__world"},
+		{"with uppercase and space", "Hello World", "_", "hello_world"},
 		{"custom delimiter", "HelloWorld", "-", "hello-world"},
 		{"already snake", "hello_world", "_", "hello_world"},
-		{"all lowercase", "hello world", "_", "hello world"},
+		{"all lowercase", "hello world", "_", "hello_world"},
 		{"mixed", "HelloWorld", "_", "hello_world"},
+		{"acronym", "HTTPServer", "_", "http_server"},
+		{"trailing acronym", "userID", "_", "user_id"},
+		{"digits", "base64Encode", "_", "base64_encode"},
+		{"dots", "config.fileName", "_", "config_file_name"},
+		{"unicode", "ÜberCool straße", "_", "über_cool_straße"},
+		{"kebab to snake", "hello-world", "_", "hello_world"},
 	}
 
 	for _, tt := range tests {
@@ -1103,6 +1166,9 @@ func TestStudly(t *testing.T) {
 		{"snake case", "hello_world", "HelloWorld"},
 		{"kebab case", "hello-world", "HelloWorld"},
 		{"already studly", "HelloWorld", "HelloWorld"},
+		{"acronym", "HTTPServer", "HTTPServer"},
+		{"trailing acronym", "userID", "UserID"},
+		{"unicode", "über cool", "ÜberCool"},
 	}
 
 	for _, tt := range tests {
@@ -1338,6 +1404,8 @@ func TestUcsplit(t *testing.T) {
 		{"single", "Hello", []string{"Hello"}},
 		{"empty", "", []string{}},
 		{"no uppercase", "hello", []string{"hello"}},
+		{"acronym", "HTTPServer", []string{"HTTP", "Server"}},
+		{"separators", "Hello World", []string{"Hello", "World"}},
 	}
 
 	for _, tt := range tests {
@@ -1639,7 +1707,15 @@ func TestSlug(t *testing.T) {
 		expected   string
 	}{
 		{"basic", "Hello World", "-", "", nil, "hello-world"},
-		{"with special chars", "Hello!@#World", "-", "", nil, "helloworld"},
+		{"with special chars", "Hello!@#World", "-", "", nil, "hello-at-world"},
+		{"without default dictionary", "Hello!@#World", "-", "", map[string]string{}, "helloworld"},
+		{"accents", "Café Déjà Vu", "-", "", nil, "cafe-deja-vu"},
+		{"german", "Über die Straße", "-", "de", nil, "ueber-die-strasse"},
+		{"russian", "Привет, мир!", "-", "ru", nil, "privet-mir"},
+		{"bulgarian", "Щастие", "-", "bg", nil, "shtastie"},
+		{"greek", "Καλημέρα κόσμε", "-", "el", nil, "kalimera-kosme"},
+		{"vietnamese", "Tiếng Việt", "-", "vi", nil, "tieng-viet"},
+		{"non-ascii dictionary", "5 €", "-", "", map[string]string{"€": "euro"}, "5-euro"},
 		{"with dictionary", "hello world", "-", "", map[string]string{"hello": "hi"}, "hi-world"},
 		{"custom separator", "Hello World", "_", "", nil, "hello_world"},
 		{"multiple spaces", "Hello   World", "-", "", nil, "hello-world"},
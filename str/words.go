@@ -0,0 +1,164 @@
+package str
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+)
+
+// DefaultWordSeparators are the characters that separate words besides
+// whitespace, unless changed with SetWordSeparators.
+const DefaultWordSeparators = "_-."
+
+var (
+	// Mutex for thread-safe access to the word splitting settings
+	wordsMu sync.RWMutex
+
+	wordSeparators = DefaultWordSeparators
+
+	// Initialisms by their lowercase form, such as "id" for "ID"
+	initialisms = map[string]string{}
+)
+
+// wordSpan is the byte range of a word within a string.
+type wordSpan struct {
+	start, end int
+}
+
+// SetWordSeparators sets the characters that separate words besides
+// whitespace, such as "_-." by default, and flushes the casing caches. Other
+// characters that are neither letters nor digits, such as apostrophes, are
+// kept inside words.
+func SetWordSeparators(separators string) {
+	wordsMu.Lock()
+	wordSeparators = separators
+	wordsMu.Unlock()
+	FlushCache()
+}
+
+// RegisterInitialisms registers words that Studly, Camel and Title write in a
+// fixed case, such as "ID", "URL" and "HTTP", so "user_id" becomes "UserID"
+// and Snake and Studly round-trip Go-style names. It flushes the casing
+// caches.
+func RegisterInitialisms(words ...string) {
+	wordsMu.Lock()
+	for _, word := range words {
+		initialisms[strings.ToLower(word)] = word
+	}
+	wordsMu.Unlock()
+	FlushCache()
+}
+
+// SplitWords splits a value into words at whitespace, word separators and
+// changes of case. A run of capitals is one word, so "HTTPServer" splits into
+// "HTTP" and "Server" and "userID" into "user" and "ID". Digits belong to the
+// word before them, and a capital after a digit starts a new word, so
+// "Base64Encode" splits into "Base64" and "Encode".
+func SplitWords(value string) []string {
+	spans := wordSpans(value)
+	if len(spans) == 0 {
+		return nil
+	}
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		words[i] = value[span.start:span.end]
+	}
+	return words
+}
+
+// wordSpans returns the byte ranges of the words in value, as split by SplitWords.
+func wordSpans(value string) []wordSpan {
+	wordsMu.RLock()
+	separators := wordSeparators
+	wordsMu.RUnlock()
+
+	var spans []wordSpan
+	start := -1
+	var prev rune
+	for i, r := range value {
+		if unicode.IsSpace(r) || strings.ContainsRune(separators, r) {
+			if start >= 0 {
+				spans = append(spans, wordSpan{start, i})
+				start = -1
+			}
+			continue
+		}
+		// Combining marks belong to the letter before them
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		if start >= 0 {
+			next, _ := utf8.DecodeRuneInString(value[i+utf8.RuneLen(r):])
+			if isWordBoundary(prev, r, next) {
+				spans = append(spans, wordSpan{start, i})
+				start = i
+			}
+		} else {
+			start = i
+		}
+		prev = r
+	}
+	if start >= 0 {
+		spans = append(spans, wordSpan{start, len(value)})
+	}
+	return spans
+}
+
+// isWordBoundary reports whether a new word starts at r, which follows prev
+// and is followed by next.
+func isWordBoundary(prev, r, next rune) bool {
+	if !isUpperRune(r) {
+		return false
+	}
+	switch {
+	case unicode.IsLower(prev), unicode.IsDigit(prev):
+		return true
+	case isUpperRune(prev):
+		// The last capital of a run starts the next word, as in "HTTPServer"
+		return unicode.IsLower(next)
+	default:
+		return false
+	}
+}
+
+// isUpperRune reports whether r is an uppercase or titlecase letter.
+func isUpperRune(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+// initialism returns the registered form of word, if it is an initialism.
+func initialism(word string) (string, bool) {
+	wordsMu.RLock()
+	defer wordsMu.RUnlock()
+	form, ok := initialisms[strings.ToLower(word)]
+	return form, ok
+}
+
+// studlyWord returns word as it is written in StudlyCase.
+func studlyWord(word string) string {
+	if form, ok := initialism(word); ok {
+		return form
+	}
+	return Ucfirst(word)
+}
+
+// camelWord returns word as the first word of camelCase, which is lowercase
+// when it is an initialism or written in capitals.
+func camelWord(word string) string {
+	if _, ok := initialism(word); ok || isUpperWord(word) {
+		return strings.ToLower(word)
+	}
+	return Lcfirst(word)
+}
+
+// titleWord returns word in title case.
+func titleWord(caser cases.Caser, word string) string {
+	if form, ok := initialism(word); ok {
+		return form
+	}
+	return caser.String(word)
+}
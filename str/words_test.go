@@ -0,0 +1,111 @@
+package str
+
+import (
+	"slices"
+	"testing"
+)
+
+// resetWords restores the default word separators and removes registered
+// initialisms.
+func resetWords() {
+	wordsMu.Lock()
+	clear(initialisms)
+	wordsMu.Unlock()
+	SetWordSeparators(DefaultWordSeparators)
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"spaces", "hello world", []string{"hello", "world"}},
+		{"separators", "hello_world-foo.bar", []string{"hello", "world", "foo", "bar"}},
+		{"camel", "helloWorld", []string{"hello", "World"}},
+		{"studly", "HelloWorld", []string{"Hello", "World"}},
+		{"leading acronym", "HTTPServer", []string{"HTTP", "Server"}},
+		{"trailing acronym", "userID", []string{"user", "ID"}},
+		{"acronym between words", "parseURLPath", []string{"parse", "URL", "Path"}},
+		{"capitals", "HELLO WORLD", []string{"HELLO", "WORLD"}},
+		{"digits", "base64Encode", []string{"base64", "Encode"}},
+		{"acronym with digits", "HTTP2Server", []string{"HTTP2", "Server"}},
+		{"leading digits", "3rdParty", []string{"3rd", "Party"}},
+		{"unicode", "ÜberCool", []string{"Über", "Cool"}},
+		{"combining marks", "CaféNoir", []string{"Café", "Noir"}},
+		{"titlecase", "ǅemal", []string{"ǅemal"}},
+		{"caseless", "東京 tower", []string{"東京", "tower"}},
+		{"punctuation", "don't stop", []string{"don't", "stop"}},
+		{"repeated separators", "  hello__world  ", []string{"hello", "world"}},
+		{"empty", "", nil},
+		{"only separators", "_-. ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := SplitWords(tt.value); !slices.Equal(result, tt.expected) {
+				t.Errorf("SplitWords(%q) = %q, want %q", tt.value, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetWordSeparators(t *testing.T) {
+	defer resetWords()
+
+	if result := Snake("path/to.file", "_"); result != "path/to_file" {
+		t.Errorf("Snake() with default separators = %q, want %q", result, "path/to_file")
+	}
+
+	SetWordSeparators("/")
+	tests := []struct {
+		name     string
+		fn       func() string
+		expected string
+	}{
+		{"split", func() string { return Snake("path/to.file", "_") }, "path_to.file"},
+		{"whitespace", func() string { return Snake("path to", "_") }, "path_to"},
+		{"not a separator", func() string { return Kebab("snake_case") }, "snake_case"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.fn(); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterInitialisms(t *testing.T) {
+	defer resetWords()
+
+	if result := Studly("user_id"); result != "UserId" {
+		t.Errorf("Studly() before registering = %q, want %q", result, "UserId")
+	}
+
+	RegisterInitialisms("ID", "URL", "HTTP")
+	tests := []struct {
+		name     string
+		fn       func() string
+		expected string
+	}{
+		{"studly", func() string { return Studly("user_id") }, "UserID"},
+		{"studly round trip", func() string { return Studly(Snake("ParseURLPath", "_")) }, "ParseURLPath"},
+		{"snake round trip", func() string { return Snake(Studly("http_server_id"), "_") }, "http_server_id"},
+		{"camel", func() string { return Camel("url_path") }, "urlPath"},
+		{"camel later word", func() string { return Camel("base_url") }, "baseURL"},
+		{"title", func() string { return Title("http server") }, "HTTP Server"},
+		{"plural", func() string { return Plural("URL", 2) }, "URLs"},
+		{"plural studly", func() string { return PluralStudly(Studly("user_id"), 2) }, "UserIDs"},
+		{"singular", func() string { return Singular("URLs") }, "URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.fn(); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}